@@ -0,0 +1,274 @@
+package api
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/shufflingpixels/antelope-go/chain"
+	"github.com/shufflingpixels/antelope-go/ship"
+)
+
+// AbiHistoryBackend persists the abi history.
+type AbiHistoryBackend interface {
+	// Store the raw abi set for account at blockNum, abi is empty if the abi was removed.
+	Put(account chain.Name, blockNum uint32, abi []byte) error
+	// Call fn for every stored entry, in the order they were stored.
+	Load(fn func(account chain.Name, blockNum uint32, abi []byte) error) error
+}
+
+// AbiHistory records the abis set by "eosio::setabi" actions so actions can be
+// decoded with the abi that was active at the block they were executed in.
+//
+// Entries are never removed, so only irreversible blocks should be recorded.
+type AbiHistory struct {
+	backend AbiHistoryBackend
+
+	mu       sync.Mutex
+	accounts map[chain.Name][]*abiHistoryEntry
+}
+
+type abiHistoryEntry struct {
+	blockNum uint32
+	raw      []byte
+	abi      *chain.ResolvedAbi
+}
+
+// Create a new abi history, existing entries are loaded from the backend.
+// If backend is nil, the history is only kept in memory.
+func NewAbiHistory(backend AbiHistoryBackend) (*AbiHistory, error) {
+	h := &AbiHistory{
+		backend:  backend,
+		accounts: make(map[chain.Name][]*abiHistoryEntry),
+	}
+	if backend != nil {
+		err := backend.Load(func(account chain.Name, blockNum uint32, abi []byte) error {
+			h.insert(account, blockNum, abi)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return h, nil
+}
+
+// Record the raw abi set for account at blockNum, an empty abi means the account abi was removed.
+func (h *AbiHistory) Record(account chain.Name, blockNum uint32, abi []byte) error {
+	// Make sure the abi can be decoded before it is persisted.
+	if len(abi) > 0 {
+		if _, err := decodeRawAbi(abi); err != nil {
+			return fmt.Errorf("abi for %s at block %d: %w", account, blockNum, err)
+		}
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.backend != nil {
+		if err := h.backend.Put(account, blockNum, abi); err != nil {
+			return err
+		}
+	}
+	h.insert(account, blockNum, abi)
+	return nil
+}
+
+// Record the abi if act is an "eosio::setabi" action. Returns true if it was.
+func (h *AbiHistory) RecordAction(blockNum uint32, act chain.Action) (bool, error) {
+	if act.Account != setAbiAccount || act.Name != setAbiAction {
+		return false, nil
+	}
+
+	var setabi struct {
+		Account chain.Name
+		Abi     chain.Bytes
+	}
+	if err := chain.NewDecoder(bytes.NewReader(act.Data)).Decode(&setabi); err != nil {
+		return true, err
+	}
+	return true, h.Record(setabi.Account, blockNum, setabi.Abi)
+}
+
+// Record all setabi actions executed in the transaction traces of a block from the state history plugin.
+// This includes setabi actions sent inline (for example by eosio.msig).
+func (h *AbiHistory) RecordTraces(blockNum uint32, traces []ship.TransactionTrace) error {
+	for _, trace := range traces {
+		if trace.V0 == nil || trace.V0.Status != chain.TransactionStatusExecuted {
+			continue
+		}
+		for _, at := range trace.V0.ActionTraces {
+			var receipt *ship.ActionReceipt
+			var receiver chain.Name
+			var act chain.Action
+			switch {
+			case at.V0 != nil:
+				receipt, receiver, act = at.V0.Receipt, at.V0.Receiver, at.V0.Act
+			case at.V1 != nil:
+				receipt, receiver, act = at.V1.Receipt, at.V1.Receiver, at.V1.Act
+			default:
+				continue
+			}
+			// skip notifications and actions that did not execute.
+			if receipt == nil || receiver != act.Account {
+				continue
+			}
+			if _, err := h.RecordAction(blockNum, act); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Record all setabi actions in a block from "/v1/chain/get_block".
+// Inline setabi actions are not part of the block, use RecordTraces if that matters.
+func (h *AbiHistory) RecordBlock(block Block) error {
+	for _, receipt := range block.Transactions {
+		if receipt.Status != chain.TransactionStatusExecuted || receipt.Trx.Packed == nil {
+			continue
+		}
+		tx, err := receipt.Trx.Packed.Unpack()
+		if err != nil {
+			return err
+		}
+		for _, act := range tx.Actions {
+			if _, err := h.RecordAction(uint32(block.BlockNum), act); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// AbiAt returns the abi of account that was active at the end of blockNum.
+// Actions executed in the same block before a setabi need the abi at blockNum - 1.
+func (h *AbiHistory) AbiAt(account chain.Name, blockNum uint32) (*chain.ResolvedAbi, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	entries := h.accounts[account]
+	i := sort.Search(len(entries), func(i int) bool {
+		return entries[i].blockNum > blockNum
+	})
+	if i < 1 || len(entries[i-1].raw) < 1 {
+		return nil, fmt.Errorf("%s at block %d: %w", account, blockNum, ErrAbiNotFound)
+	}
+
+	entry := entries[i-1]
+	if entry.abi == nil {
+		abi, err := decodeRawAbi(entry.raw)
+		if err != nil {
+			return nil, err
+		}
+		entry.abi = chain.NewResolvedAbi(*abi)
+	}
+	return entry.abi, nil
+}
+
+// Insert an entry, keeping the entries of each account sorted by block number.
+// An existing entry for the same block is replaced.
+func (h *AbiHistory) insert(account chain.Name, blockNum uint32, abi []byte) {
+	entries := h.accounts[account]
+	i := sort.Search(len(entries), func(i int) bool {
+		return entries[i].blockNum >= blockNum
+	})
+	entry := &abiHistoryEntry{blockNum: blockNum, raw: abi}
+	if i < len(entries) && entries[i].blockNum == blockNum {
+		entries[i] = entry
+		return
+	}
+	entries = append(entries, nil)
+	copy(entries[i+1:], entries[i:])
+	entries[i] = entry
+	h.accounts[account] = entries
+}
+
+// FileAbiHistoryBackend stores the abi history in an append only file.
+//
+// Each record is <uint32 length><account><block_num><abi bytes> (abi encoded).
+type FileAbiHistoryBackend struct {
+	path string
+	file *os.File
+}
+
+// Open (or create) the history file at path.
+func NewFileAbiHistoryBackend(path string) (*FileAbiHistoryBackend, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	return &FileAbiHistoryBackend{path: path, file: f}, nil
+}
+
+type abiHistoryRecord struct {
+	Account  chain.Name
+	BlockNum uint32
+	Abi      chain.Bytes
+}
+
+func (b *FileAbiHistoryBackend) Put(account chain.Name, blockNum uint32, abi []byte) error {
+	buf := bytes.NewBuffer(make([]byte, 4))
+	err := chain.NewEncoder(buf).Encode(abiHistoryRecord{account, blockNum, abi})
+	if err != nil {
+		return err
+	}
+	data := buf.Bytes()
+	binary.LittleEndian.PutUint32(data, uint32(len(data)-4))
+	_, err = b.file.Write(data)
+	return err
+}
+
+// Load all records. A partially written record at the end of the file
+// (for example after a crash) is discarded.
+func (b *FileAbiHistoryBackend) Load(fn func(account chain.Name, blockNum uint32, abi []byte) error) error {
+	if _, err := b.file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	var offset int64
+	header := make([]byte, 4)
+	for {
+		_, err := io.ReadFull(b.file, header)
+		if err == io.EOF {
+			break
+		} else if errors.Is(err, io.ErrUnexpectedEOF) {
+			return b.truncate(offset)
+		} else if err != nil {
+			return err
+		}
+
+		data := make([]byte, binary.LittleEndian.Uint32(header))
+		if _, err := io.ReadFull(b.file, data); errors.Is(err, io.ErrUnexpectedEOF) || err == io.EOF {
+			return b.truncate(offset)
+		} else if err != nil {
+			return err
+		}
+
+		var rec abiHistoryRecord
+		if err := chain.NewDecoder(bytes.NewReader(data)).Decode(&rec); err != nil {
+			return fmt.Errorf("%s: invalid record at offset %d: %w", b.path, offset, err)
+		}
+		if err := fn(rec.Account, rec.BlockNum, rec.Abi); err != nil {
+			return err
+		}
+		offset += int64(len(header) + len(data))
+	}
+	return nil
+}
+
+func (b *FileAbiHistoryBackend) Close() error {
+	return b.file.Close()
+}
+
+func (b *FileAbiHistoryBackend) truncate(offset int64) error {
+	if err := b.file.Truncate(offset); err != nil {
+		return err
+	}
+	_, err := b.file.Seek(offset, io.SeekStart)
+	return err
+}
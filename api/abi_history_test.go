@@ -0,0 +1,202 @@
+package api
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/shufflingpixels/antelope-go/chain"
+	"github.com/shufflingpixels/antelope-go/ship"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSetAbiAction(t *testing.T, account chain.Name, abi []byte) chain.Action {
+	b := bytes.NewBuffer(nil)
+	enc := chain.NewEncoder(b)
+	require.NoError(t, enc.Encode(account))
+	require.NoError(t, enc.Encode(chain.Bytes(abi)))
+	return *chain.NewAction(chain.N("eosio"), chain.N("setabi"), nil, b.Bytes())
+}
+
+func abiVersion(version string) chain.Abi {
+	abi := tokenAbi
+	abi.Version = version
+	return abi
+}
+
+func TestAbiHistoryAbiAt(t *testing.T) {
+	token := chain.N("eosio.token")
+	h, err := NewAbiHistory(nil)
+	require.NoError(t, err)
+
+	require.NoError(t, h.Record(token, 100, encodeAbi(t, abiVersion("eosio::abi/1.0"))))
+	require.NoError(t, h.Record(token, 300, nil))
+	require.NoError(t, h.Record(token, 200, encodeAbi(t, abiVersion("eosio::abi/1.1"))))
+	require.NoError(t, h.Record(token, 400, encodeAbi(t, abiVersion("eosio::abi/1.2"))))
+
+	tests := []struct {
+		blockNum uint32
+		version  string
+	}{
+		{100, "eosio::abi/1.0"},
+		{199, "eosio::abi/1.0"},
+		{200, "eosio::abi/1.1"},
+		{299, "eosio::abi/1.1"},
+		{400, "eosio::abi/1.2"},
+		{10000, "eosio::abi/1.2"},
+	}
+
+	for _, test := range tests {
+		abi, err := h.AbiAt(token, test.blockNum)
+		require.NoError(t, err, test.blockNum)
+		assert.Equal(t, test.version, abi.Version, test.blockNum)
+	}
+
+	// before the first setabi and after the abi was removed.
+	for _, blockNum := range []uint32{1, 99, 300, 399} {
+		_, err := h.AbiAt(token, blockNum)
+		assert.ErrorIs(t, err, ErrAbiNotFound, blockNum)
+	}
+
+	_, err = h.AbiAt(chain.N("noabi"), 1000)
+	assert.ErrorIs(t, err, ErrAbiNotFound)
+
+	// The resolved abi is reused.
+	a, err := h.AbiAt(token, 250)
+	require.NoError(t, err)
+	b, err := h.AbiAt(token, 251)
+	require.NoError(t, err)
+	assert.Same(t, a, b)
+}
+
+func TestAbiHistoryRecordInvalidAbi(t *testing.T) {
+	h, err := NewAbiHistory(nil)
+	require.NoError(t, err)
+
+	assert.Error(t, h.Record(chain.N("eosio.token"), 100, []byte{0xff, 0xff}))
+	_, err = h.AbiAt(chain.N("eosio.token"), 100)
+	assert.ErrorIs(t, err, ErrAbiNotFound)
+}
+
+func TestAbiHistoryRecordAction(t *testing.T) {
+	h, err := NewAbiHistory(nil)
+	require.NoError(t, err)
+
+	transfer := chain.NewAction(chain.N("eosio.token"), chain.N("transfer"), nil, nil)
+	ok, err := h.RecordAction(100, *transfer)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	ok, err = h.RecordAction(100, newSetAbiAction(t, chain.N("eosio.token"), encodeAbi(t, tokenAbi)))
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	abi, err := h.AbiAt(chain.N("eosio.token"), 100)
+	require.NoError(t, err)
+	assert.Equal(t, tokenAbi.Version, abi.Version)
+}
+
+func TestAbiHistoryRecordTraces(t *testing.T) {
+	h, err := NewAbiHistory(nil)
+	require.NoError(t, err)
+
+	receipt := &ship.ActionReceipt{V0: &ship.ActionReceiptV0{}}
+	trace := func(status chain.TransactionStatus, traces ...*ship.ActionTrace) ship.TransactionTrace {
+		return ship.TransactionTrace{V0: &ship.TransactionTraceV0{Status: status, ActionTraces: traces}}
+	}
+
+	traces := []ship.TransactionTrace{
+		// executed.
+		trace(chain.TransactionStatusExecuted, &ship.ActionTrace{V1: &ship.ActionTraceV1{
+			Receipt:  receipt,
+			Receiver: chain.N("eosio"),
+			Act:      newSetAbiAction(t, chain.N("eosio.token"), encodeAbi(t, tokenAbi)),
+		}}),
+		// notification.
+		trace(chain.TransactionStatusExecuted, &ship.ActionTrace{V0: &ship.ActionTraceV0{
+			Receipt:  receipt,
+			Receiver: chain.N("alice"),
+			Act:      newSetAbiAction(t, chain.N("alice"), encodeAbi(t, tokenAbi)),
+		}}),
+		// failed transaction.
+		trace(chain.TransactionStatusHardFail, &ship.ActionTrace{V1: &ship.ActionTraceV1{
+			Receipt:  receipt,
+			Receiver: chain.N("eosio"),
+			Act:      newSetAbiAction(t, chain.N("bob"), encodeAbi(t, tokenAbi)),
+		}}),
+		// no receipt.
+		trace(chain.TransactionStatusExecuted, &ship.ActionTrace{V1: &ship.ActionTraceV1{
+			Receiver: chain.N("eosio"),
+			Act:      newSetAbiAction(t, chain.N("carol"), encodeAbi(t, tokenAbi)),
+		}}),
+	}
+
+	require.NoError(t, h.RecordTraces(100, traces))
+
+	_, err = h.AbiAt(chain.N("eosio.token"), 100)
+	assert.NoError(t, err)
+	for _, account := range []string{"alice", "bob", "carol"} {
+		_, err = h.AbiAt(chain.N(account), 100)
+		assert.ErrorIs(t, err, ErrAbiNotFound, account)
+	}
+}
+
+func TestFileAbiHistoryBackend(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "abi_history")
+	token := chain.N("eosio.token")
+
+	backend, err := NewFileAbiHistoryBackend(path)
+	require.NoError(t, err)
+	h, err := NewAbiHistory(backend)
+	require.NoError(t, err)
+	require.NoError(t, h.Record(token, 100, encodeAbi(t, abiVersion("eosio::abi/1.0"))))
+	require.NoError(t, h.Record(token, 200, encodeAbi(t, abiVersion("eosio::abi/1.1"))))
+	require.NoError(t, h.Record(token, 300, nil))
+	require.NoError(t, backend.Close())
+
+	// Simulate a crash in the middle of a write.
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{0x10, 0x00, 0x00, 0x00, 0x01, 0x02})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	backend, err = NewFileAbiHistoryBackend(path)
+	require.NoError(t, err)
+	h, err = NewAbiHistory(backend)
+	require.NoError(t, err)
+
+	truncated, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, info.Size(), truncated.Size())
+
+	abi, err := h.AbiAt(token, 150)
+	require.NoError(t, err)
+	assert.Equal(t, "eosio::abi/1.0", abi.Version)
+	abi, err = h.AbiAt(token, 250)
+	require.NoError(t, err)
+	assert.Equal(t, "eosio::abi/1.1", abi.Version)
+	_, err = h.AbiAt(token, 300)
+	assert.ErrorIs(t, err, ErrAbiNotFound)
+
+	// New records are appended after the existing ones.
+	require.NoError(t, h.Record(token, 400, encodeAbi(t, abiVersion("eosio::abi/1.2"))))
+	require.NoError(t, backend.Close())
+
+	backend, err = NewFileAbiHistoryBackend(path)
+	require.NoError(t, err)
+	defer backend.Close()
+	h, err = NewAbiHistory(backend)
+	require.NoError(t, err)
+
+	abi, err = h.AbiAt(token, 400)
+	require.NoError(t, err)
+	assert.Equal(t, "eosio::abi/1.2", abi.Version)
+	abi, err = h.AbiAt(token, 150)
+	require.NoError(t, err)
+	assert.Equal(t, "eosio::abi/1.0", abi.Version)
+}
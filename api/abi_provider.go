@@ -0,0 +1,232 @@
+package api
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shufflingpixels/antelope-go/chain"
+)
+
+var ErrAbiNotFound = errors.New("account has no abi")
+
+// Default number of abis kept by AbiCache.
+const DefaultAbiCacheSize = 256
+
+var (
+	setAbiAccount = chain.N("eosio")
+	setAbiAction  = chain.N("setabi")
+)
+
+// AbiProvider returns the abi of an account.
+type AbiProvider interface {
+	Abi(ctx context.Context, account chain.Name) (*chain.ResolvedAbi, error)
+}
+
+// AbiCache is an AbiProvider that keeps the most recently used abis in memory
+// and fetches missing ones with "/v1/chain/get_raw_abi".
+//
+// Expired abis are revalidated with the abi hash, so they are only downloaded again if they changed.
+type AbiCache struct {
+	client *Client
+	size   int
+	expiry time.Duration
+
+	mu      sync.Mutex
+	lru     *list.List
+	entries map[chain.Name]*list.Element
+}
+
+type abiCacheEntry struct {
+	account chain.Name
+	abi     *chain.ResolvedAbi
+	hash    chain.Checksum256
+	updated time.Time
+}
+
+// Create a new cache that holds up to size abis, zero or less uses DefaultAbiCacheSize.
+func NewAbiCache(client *Client, size int) *AbiCache {
+	if size < 1 {
+		size = DefaultAbiCacheSize
+	}
+	return &AbiCache{
+		client:  client,
+		size:    size,
+		lru:     list.New(),
+		entries: make(map[chain.Name]*list.Element),
+	}
+}
+
+// Revalidate abis older than d, zero disables expiry.
+func (c *AbiCache) SetExpiry(d time.Duration) *AbiCache {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.expiry = d
+	return c
+}
+
+// Abi returns the abi of account, fetching it from the api if it's not cached or has expired.
+func (c *AbiCache) Abi(ctx context.Context, account chain.Name) (*chain.ResolvedAbi, error) {
+	c.mu.Lock()
+	var cached abiCacheEntry
+	entry := c.get(account)
+	if entry != nil {
+		cached = *entry
+	}
+	expiry := c.expiry
+	c.mu.Unlock()
+
+	if entry != nil && (expiry <= 0 || time.Since(cached.updated) < expiry) {
+		return cached.abi, nil
+	}
+
+	var resp RawAbiResp
+	var err error
+	if entry != nil {
+		resp, err = c.client.GetRawAbiIfChanged(ctx, account, cached.hash)
+	} else {
+		resp, err = c.client.GetRawAbi(ctx, account)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Unchanged, just refresh the entry.
+	if entry != nil && resp.Abi == nil && resp.AbiHash == cached.hash {
+		c.mu.Lock()
+		entry.updated = time.Now()
+		c.mu.Unlock()
+		return cached.abi, nil
+	}
+	if resp.Abi == nil {
+		c.Invalidate(account)
+		return nil, fmt.Errorf("%s: %w", account, ErrAbiNotFound)
+	}
+
+	ra := chain.NewResolvedAbi(*resp.Abi)
+	c.mu.Lock()
+	c.put(account, ra, resp.AbiHash)
+	c.mu.Unlock()
+	return ra, nil
+}
+
+// Add an abi to the cache, replacing any existing abi for the account.
+func (c *AbiCache) Set(account chain.Name, abi chain.Abi) error {
+	b := bytes.NewBuffer(nil)
+	if err := chain.NewEncoder(b).Encode(abi); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.put(account, chain.NewResolvedAbi(abi), chain.Checksum256Digest(b.Bytes()))
+	return nil
+}
+
+// Remove the abi of account from the cache.
+func (c *AbiCache) Invalidate(account chain.Name) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[account]; ok {
+		c.lru.Remove(el)
+		delete(c.entries, account)
+	}
+}
+
+// Invalidate the account if act is an "eosio::setabi" action.
+// Returns true if the action was a setabi.
+func (c *AbiCache) InvalidateOnSetAbi(act chain.Action) (bool, error) {
+	account, ok, err := setAbiTarget(act)
+	if !ok || err != nil {
+		return ok, err
+	}
+	c.Invalidate(account)
+	return true, nil
+}
+
+// Load an abi from a json file (the .abi file produced by the contract compiler).
+func (c *AbiCache) LoadFile(account chain.Name, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var abi chain.Abi
+	if err := json.Unmarshal(data, &abi); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return c.Set(account, abi)
+}
+
+// Load all "<account>.abi" files in dir.
+func (c *AbiCache) LoadDir(dir string) error {
+	files, err := filepath.Glob(filepath.Join(dir, "*.abi"))
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		var account chain.Name
+		name := strings.TrimSuffix(filepath.Base(file), ".abi")
+		if err := account.UnmarshalText([]byte(name)); err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+		if err := c.LoadFile(account, file); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Number of abis in the cache.
+func (c *AbiCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+func (c *AbiCache) get(account chain.Name) *abiCacheEntry {
+	el, ok := c.entries[account]
+	if !ok {
+		return nil
+	}
+	c.lru.MoveToFront(el)
+	return el.Value.(*abiCacheEntry)
+}
+
+func (c *AbiCache) put(account chain.Name, abi *chain.ResolvedAbi, hash chain.Checksum256) {
+	entry := &abiCacheEntry{
+		account: account,
+		abi:     abi,
+		hash:    hash,
+		updated: time.Now(),
+	}
+	if el, ok := c.entries[account]; ok {
+		el.Value = entry
+		c.lru.MoveToFront(el)
+		return
+	}
+	c.entries[account] = c.lru.PushFront(entry)
+	for c.lru.Len() > c.size {
+		el := c.lru.Back()
+		c.lru.Remove(el)
+		delete(c.entries, el.Value.(*abiCacheEntry).account)
+	}
+}
+
+// Returns the account of an "eosio::setabi" action, false if act is something else.
+func setAbiTarget(act chain.Action) (chain.Name, bool, error) {
+	if act.Account != setAbiAccount || act.Name != setAbiAction {
+		return 0, false, nil
+	}
+	var account chain.Name
+	if err := chain.NewDecoder(bytes.NewReader(act.Data)).Decode(&account); err != nil {
+		return 0, true, err
+	}
+	return account, true, nil
+}
@@ -0,0 +1,183 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/shufflingpixels/antelope-go/chain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// test server for get_raw_abi that serves tokenAbi for all accounts except "noabi".
+func newRawAbiServer(t *testing.T, hits *int32) *httptest.Server {
+	raw := encodeAbi(t, tokenAbi)
+	hash := chain.Checksum256Digest(raw)
+
+	return httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(hits, 1)
+		assert.Equal(t, "/v1/chain/get_raw_abi", req.URL.String())
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+
+		var params struct {
+			AccountName string `json:"account_name"`
+			AbiHash     string `json:"abi_hash"`
+		}
+		assert.NoError(t, json.Unmarshal(body, &params))
+
+		if params.AccountName == "noabi" {
+			_, _ = res.Write([]byte(`{"account_name": "noabi", "code_hash": "0000000000000000000000000000000000000000000000000000000000000000", "abi_hash": "0000000000000000000000000000000000000000000000000000000000000000"}`))
+			return
+		}
+		payload := fmt.Sprintf(`{"account_name": "%s", "code_hash": "%s", "abi_hash": "%s"`, params.AccountName, hash, hash)
+		if params.AbiHash != hash.String() {
+			payload += fmt.Sprintf(`, "abi": "%s"`, base64.StdEncoding.EncodeToString(raw))
+		}
+		_, _ = res.Write([]byte(payload + "}"))
+	}))
+}
+
+func TestAbiCache(t *testing.T) {
+	var hits int32
+	srv := newRawAbiServer(t, &hits)
+	defer srv.Close()
+
+	var provider AbiProvider = NewAbiCache(New(srv.URL), 0)
+
+	abi, err := provider.Abi(context.Background(), chain.N("eosio.token"))
+	require.NoError(t, err)
+	assert.Equal(t, tokenAbi, abi.Abi)
+
+	data, err := hex.DecodeString(transferHexData)
+	require.NoError(t, err)
+	decoded, err := abi.DecodeAction(bytes.NewReader(data), "transfer")
+	require.NoError(t, err)
+	assert.Equal(t, "hi", decoded.(map[string]interface{})["memo"])
+
+	cached, err := provider.Abi(context.Background(), chain.N("eosio.token"))
+	require.NoError(t, err)
+	assert.True(t, abi == cached)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&hits))
+}
+
+func TestAbiCacheNotFound(t *testing.T) {
+	var hits int32
+	srv := newRawAbiServer(t, &hits)
+	defer srv.Close()
+
+	_, err := NewAbiCache(New(srv.URL), 0).Abi(context.Background(), chain.N("noabi"))
+	assert.True(t, errors.Is(err, ErrAbiNotFound))
+}
+
+func TestAbiCacheExpiry(t *testing.T) {
+	var hits int32
+	srv := newRawAbiServer(t, &hits)
+	defer srv.Close()
+
+	cache := NewAbiCache(New(srv.URL), 0).SetExpiry(10 * time.Millisecond)
+	abi, err := cache.Abi(context.Background(), chain.N("eosio.token"))
+	require.NoError(t, err)
+
+	time.Sleep(20 * time.Millisecond)
+
+	// revalidated with the hash, unchanged so the same abi is returned.
+	revalidated, err := cache.Abi(context.Background(), chain.N("eosio.token"))
+	require.NoError(t, err)
+	assert.True(t, abi == revalidated)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&hits))
+
+	_, err = cache.Abi(context.Background(), chain.N("eosio.token"))
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&hits))
+}
+
+func TestAbiCacheEviction(t *testing.T) {
+	var hits int32
+	srv := newRawAbiServer(t, &hits)
+	defer srv.Close()
+
+	cache := NewAbiCache(New(srv.URL), 2)
+	for _, account := range []string{"a", "b", "a", "c"} {
+		_, err := cache.Abi(context.Background(), chain.N(account))
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 2, cache.Len())
+	assert.Equal(t, int32(3), atomic.LoadInt32(&hits))
+
+	// b was the least recently used.
+	_, err := cache.Abi(context.Background(), chain.N("a"))
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&hits))
+	_, err = cache.Abi(context.Background(), chain.N("b"))
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), atomic.LoadInt32(&hits))
+}
+
+func TestAbiCacheInvalidateOnSetAbi(t *testing.T) {
+	var hits int32
+	srv := newRawAbiServer(t, &hits)
+	defer srv.Close()
+
+	cache := NewAbiCache(New(srv.URL), 0)
+	_, err := cache.Abi(context.Background(), chain.N("eosio.token"))
+	require.NoError(t, err)
+
+	// not a setabi action
+	transfer := chain.NewAction(chain.N("eosio.token"), chain.N("transfer"), nil, nil)
+	ok, err := cache.InvalidateOnSetAbi(*transfer)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, 1, cache.Len())
+
+	b := bytes.NewBuffer(nil)
+	enc := chain.NewEncoder(b)
+	require.NoError(t, enc.Encode(chain.N("eosio.token")))
+	require.NoError(t, enc.Encode(chain.Bytes(encodeAbi(t, tokenAbi))))
+	setabi := chain.NewAction(chain.N("eosio"), chain.N("setabi"), nil, b.Bytes())
+
+	ok, err = cache.InvalidateOnSetAbi(*setabi)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 0, cache.Len())
+
+	_, err = cache.Abi(context.Background(), chain.N("eosio.token"))
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&hits))
+}
+
+func TestAbiCacheLoadDir(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		t.Error("unexpected request")
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	data, err := json.Marshal(tokenAbi)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "eosio.token.abi"), data, 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "readme.txt"), []byte("not an abi"), 0o644))
+
+	cache := NewAbiCache(New(srv.URL), 0)
+	require.NoError(t, cache.LoadDir(dir))
+	assert.Equal(t, 1, cache.Len())
+
+	abi, err := cache.Abi(context.Background(), chain.N("eosio.token"))
+	require.NoError(t, err)
+	assert.Equal(t, tokenAbi, abi.Abi)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "broken.abi"), []byte("{"), 0o644))
+	assert.Error(t, cache.LoadDir(dir))
+}
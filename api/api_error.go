@@ -1,11 +1,46 @@
 package api
 
 import (
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
 
+// Sentinel errors for common nodeos exceptions, use errors.Is to check
+// if an APIError (or a trace Exception) is of a specific kind.
+var (
+	ErrTxCPUUsageExceeded       = errors.New("tx_cpu_usage_exceeded")
+	ErrTxNetUsageExceeded       = errors.New("tx_net_usage_exceeded")
+	ErrRAMUsageExceeded         = errors.New("ram_usage_exceeded")
+	ErrExpiredTx                = errors.New("expired_tx_exception")
+	ErrDuplicateTx              = errors.New("tx_duplicate")
+	ErrUnsatisfiedAuthorization = errors.New("unsatisfied_authorization")
+	ErrMissingAuth              = errors.New("missing_auth_exception")
+	ErrAssertMessage            = errors.New("eosio_assert_message_exception")
+	ErrAssertCode               = errors.New("eosio_assert_code_exception")
+	ErrUnknownBlock             = errors.New("unknown_block_exception")
+)
+
+// fc exception codes of the sentinel errors.
+var errorCodes = map[int64]error{
+	3080004: ErrTxCPUUsageExceeded,
+	3080002: ErrTxNetUsageExceeded,
+	3080001: ErrRAMUsageExceeded,
+	3040005: ErrExpiredTx,
+	3040008: ErrDuplicateTx,
+	3090003: ErrUnsatisfiedAuthorization,
+	3090004: ErrMissingAuth,
+	3050003: ErrAssertMessage,
+	3050004: ErrAssertCode,
+	3100002: ErrUnknownBlock,
+}
+
+// prefix of the message nodeos uses for eosio_assert failures.
+const assertMessagePrefix = "assertion failure with message: "
+
 type APIErrorDetail struct {
 	Message string `json:"message"`
 	File    string `json:"file"`
@@ -24,6 +59,8 @@ type APIError struct {
 	Code    int64         `json:"code"`
 	Message string        `json:"message"`
 	Err     APIErrorInner `json:"error"`
+	// Value of the Retry-After header, zero if not present.
+	RetryAfter time.Duration `json:"-"`
 }
 
 func (e *APIError) IsEmpty() bool {
@@ -33,3 +70,75 @@ func (e *APIError) IsEmpty() bool {
 func (e APIError) Error() string {
 	return fmt.Sprintf("%d %s", e.Code, e.Message)
 }
+
+// Returns the sentinel error matching the exception name or code, nil if there is none.
+func (e APIError) Kind() error {
+	return exceptionKind(e.Err.Name, e.Err.Code)
+}
+
+// Support for errors.Is, matches the sentinel errors.
+func (e APIError) Is(target error) bool {
+	kind := e.Kind()
+	return kind != nil && kind == target
+}
+
+// Message passed to eosio_assert (or check) by the contract.
+// returns false if the error is not an assertion failure.
+func (e APIError) AssertMessage() (string, bool) {
+	for _, d := range e.Err.Details {
+		if strings.HasPrefix(d.Message, assertMessagePrefix) {
+			return d.Message[len(assertMessagePrefix):], true
+		}
+	}
+	return "", false
+}
+
+// Returns the sentinel error matching the exception name or code, nil if there is none.
+func (e Exception) Kind() error {
+	return exceptionKind(e.Name, e.Code)
+}
+
+// Support for errors.Is, matches the sentinel errors.
+func (e Exception) Is(target error) bool {
+	kind := e.Kind()
+	return kind != nil && kind == target
+}
+
+// Message passed to eosio_assert (or check) by the contract.
+// returns false if the exception is not an assertion failure.
+func (e Exception) AssertMessage() (string, bool) {
+	for _, s := range e.Stack {
+		if !strings.HasPrefix(s.Format, assertMessagePrefix) {
+			continue
+		}
+		if msg, ok := s.Data["s"].(string); ok {
+			return msg, true
+		}
+	}
+	if strings.HasPrefix(e.Message, assertMessagePrefix) {
+		return e.Message[len(assertMessagePrefix):], true
+	}
+	return "", false
+}
+
+// Extract the assert message from an APIError or Exception anywhere in the error chain.
+func AssertMessage(err error) (string, bool) {
+	var apiErr APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.AssertMessage()
+	}
+	var except Exception
+	if errors.As(err, &except) {
+		return except.AssertMessage()
+	}
+	return "", false
+}
+
+func exceptionKind(name string, code int64) error {
+	for _, kind := range errorCodes {
+		if kind.Error() == name {
+			return kind
+		}
+	}
+	return errorCodes[code]
+}
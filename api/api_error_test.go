@@ -0,0 +1,93 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIErrorKind(t *testing.T) {
+	tests := []struct {
+		name string
+		code int64
+		want error
+	}{
+		{name: "tx_cpu_usage_exceeded", code: 3080004, want: ErrTxCPUUsageExceeded},
+		{name: "expired_tx_exception", code: 3040005, want: ErrExpiredTx},
+		{name: "unsatisfied_authorization", code: 3090003, want: ErrUnsatisfiedAuthorization},
+		{name: "ram_usage_exceeded", code: 3080001, want: ErrRAMUsageExceeded},
+		{name: "eosio_assert_message_exception", code: 3050003, want: ErrAssertMessage},
+		{name: "unknown_block_exception", code: 3100002, want: ErrUnknownBlock},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			byName := APIError{Code: 500, Err: APIErrorInner{Name: tt.name}}
+			assert.Equal(t, tt.want, byName.Kind())
+			assert.True(t, errors.Is(byName, tt.want))
+
+			byCode := APIError{Code: 500, Err: APIErrorInner{Code: tt.code}}
+			assert.True(t, errors.Is(byCode, tt.want))
+
+			except := Exception{Code: tt.code, Name: tt.name}
+			assert.True(t, errors.Is(except, tt.want))
+		})
+	}
+
+	unknown := APIError{Code: 500, Err: APIErrorInner{Code: 1, Name: "some_exception"}}
+	assert.Nil(t, unknown.Kind())
+	assert.False(t, errors.Is(unknown, ErrAssertMessage))
+}
+
+func TestAPIErrorAssertMessage(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(http.StatusInternalServerError)
+		_, _ = res.Write([]byte(`{
+			"code": 500,
+			"message": "Internal Service Error",
+			"error": {
+				"code": 3050003,
+				"name": "eosio_assert_message_exception",
+				"what": "eosio_assert_message assertion failure",
+				"details": [
+					{"message": "assertion failure with message: overdrawn balance", "file": "cf_system.cpp", "line_number": 14, "method": "eosio_assert"},
+					{"message": "pending console output: ", "file": "apply_context.cpp", "line_number": 124, "method": "exec_one"}
+				]
+			}
+		}`))
+	}))
+	defer srv.Close()
+
+	_, err := New(srv.URL).GetInfo(context.Background())
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrAssertMessage))
+	assert.False(t, errors.Is(err, ErrTxCPUUsageExceeded))
+
+	msg, ok := AssertMessage(fmt.Errorf("push failed: %w", err))
+	assert.True(t, ok)
+	assert.Equal(t, "overdrawn balance", msg)
+
+	_, ok = AssertMessage(HTTPError{Code: 500})
+	assert.False(t, ok)
+}
+
+func TestExceptionAssertMessage(t *testing.T) {
+	var resp SendTransactionResp
+	require.NoError(t, json.Unmarshal([]byte(sendTransactionFailureResp), &resp))
+	require.NotNil(t, resp.Processed.Except)
+
+	except := *resp.Processed.Except
+	assert.True(t, errors.Is(except, ErrAssertMessage))
+	msg, ok := AssertMessage(except)
+	assert.True(t, ok)
+	assert.Equal(t, "overdrawn balance", msg)
+
+	_, ok = Exception{Code: 3080004, Name: "tx_cpu_usage_exceeded"}.AssertMessage()
+	assert.False(t, ok)
+}
@@ -1,31 +1,107 @@
 package api
 
 import (
+	"bytes"
 	"context"
+	"io"
+	"net/http"
 	"net/url"
-
-	"github.com/imroc/req/v3"
+	"time"
 )
 
+// Timeout of the default http client, used unless a custom one is set with SetHTTPClient.
+const DefaultTimeout = 2 * time.Minute
+
 type Client struct {
-	Url    string
-	Host   string
-	client *req.Client
+	Url          string
+	Host         string
+	client       *http.Client
+	pool         *Pool
+	retry        RetryPolicy
+	limiter      *tokenBucket
+	interceptors []Interceptor
 }
 
 func New(url string) *Client {
-	rc := req.C().
-		SetJsonMarshal(json.Marshal).
-		SetJsonUnmarshal(customJsonUnmarshal)
-
 	return &Client{
 		Url:    url,
 		Host:   "",
-		client: rc,
+		client: newHTTPClient(),
+	}
+}
+
+func newHTTPClient() *http.Client {
+	return &http.Client{Timeout: DefaultTimeout}
+}
+
+// Use a custom http client (for example with another transport), nil restores the default.
+// On a pool client, the http client is set on all endpoints.
+func (c *Client) SetHTTPClient(hc *http.Client) *Client {
+	if c.pool != nil {
+		c.pool.SetHTTPClient(hc)
+		return c
+	}
+	if hc == nil {
+		hc = newHTTPClient()
+	}
+	c.client = hc
+	return c
+}
+
+// Set the retry policy used for all calls, can be overridden per call with WithRetryPolicy.
+func (c *Client) SetRetryPolicy(policy RetryPolicy) *Client {
+	c.retry = policy
+	return c
+}
+
+// Limit the client to rps requests per second, with bursts of up to burst requests.
+// A rps of zero or less removes the limit.
+// On a pool client, the limit applies to each endpoint.
+func (c *Client) SetRateLimit(rps float64, burst int) *Client {
+	if c.pool != nil {
+		c.pool.SetRateLimit(rps, burst)
+		return c
+	}
+	if rps > 0 {
+		c.limiter = newTokenBucket(rps, burst)
+	} else {
+		c.limiter = nil
 	}
+	return c
 }
 
 func (c *Client) send(ctx context.Context, method string, path string, body interface{}, out interface{}) error {
+	policy, ok := retryPolicyFromContext(ctx)
+	if !ok {
+		policy = c.retry
+	}
+
+	for attempt := 0; ; attempt++ {
+		err := c.sendOnce(ctx, method, path, body, out, policy)
+		if err == nil {
+			return nil
+		}
+		delay, retry := policy.next(attempt, path, err)
+		if !retry {
+			return err
+		}
+		if sleepContext(ctx, delay) != nil {
+			return err
+		}
+	}
+}
+
+func (c *Client) sendOnce(ctx context.Context, method string, path string, body interface{}, out interface{}, policy RetryPolicy) error {
+	if c.pool != nil {
+		return c.pool.send(ctx, method, path, body, out, policy)
+	}
+
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return err
+		}
+	}
+
 	host := c.Host
 	if len(host) < 1 {
 		u, err := url.Parse(c.Url)
@@ -35,32 +111,85 @@ func (c *Client) send(ctx context.Context, method string, path string, body inte
 		host = u.Host
 	}
 
-	// Go's net.http (that `req` uses) sends the port in the host header.
+	req := &Request{
+		Method: method,
+		Url:    c.Url + path,
+		Path:   path,
+		Header: http.Header{},
+	}
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		req.Body = b
+		req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	}
+
+	// Go's net.http sends the port in the host header.
 	// nodeos api does not like that, so we need to provide our
 	// own Host header with just the host.
-	r, err := c.client.R().
-		SetContext(ctx).
-		SetHeader("Host", host).
-		SetBody(body).
-		Send(method, c.Url+path)
+	req.Header.Set("Host", host)
+
+	resp, err := chainInterceptors(c.interceptors, c.roundTrip)(ctx, req)
 	if err != nil {
 		return err
 	}
 
-	if !r.IsError() {
-		return r.UnmarshalJson(&out)
+	if resp.StatusCode < 400 {
+		return customJsonUnmarshal(resp.Body, &out)
 	}
 
-	return handleError(r)
+	return handleError(resp)
 }
 
-func handleError(r *req.Response) error {
+// Performs the actual http request, this is the last handler in the interceptor chain.
+func (c *Client) roundTrip(ctx context.Context, req *Request) (*Response, error) {
+	var body io.Reader
+	if req.Body != nil {
+		body = bytes.NewReader(req.Body)
+	}
+	r, err := http.NewRequestWithContext(ctx, req.Method, req.Url, body)
+	if err != nil {
+		return nil, err
+	}
+	r.Header = req.Header.Clone()
+	if host := r.Header.Get("Host"); len(host) > 0 {
+		r.Host = host
+		r.Header.Del("Host")
+	}
+
+	start := time.Now()
+	res, err := c.client.Do(r)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &Response{
+		StatusCode: res.StatusCode,
+		Header:     res.Header,
+		Body:       data,
+		Duration:   time.Since(start),
+	}, nil
+}
+
+func handleError(r *Response) error {
 	var api_err APIError
+	retryAfter := parseRetryAfter(r.Header.Get("Retry-After"))
 	// Parse error object.
-	err := r.UnmarshalJson(&api_err)
+	err := customJsonUnmarshal(r.Body, &api_err)
 	if err != nil || api_err.IsEmpty() {
 		// Failed to parse error object. just return an generic HTTP error
-		return HTTPError{Code: r.StatusCode}
+		return HTTPError{
+			Code:       r.StatusCode,
+			RetryAfter: retryAfter,
+		}
 	}
+	api_err.RetryAfter = retryAfter
 	return api_err
 }
@@ -0,0 +1,104 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/shufflingpixels/antelope-go/chain"
+)
+
+type AccountResourceLimit struct {
+	Used                chain.Int64 `json:"used"`
+	Available           chain.Int64 `json:"available"`
+	Max                 chain.Int64 `json:"max"`
+	LastUsageUpdateTime time.Time   `json:"last_usage_update_time" time_format:"antelope-api" time_location:"antelope-api"`
+	CurrentUsed         chain.Int64 `json:"current_used"`
+}
+
+type PermissionLinkedAction struct {
+	Account chain.Name  `json:"account"`
+	Action  *chain.Name `json:"action,omitempty"`
+}
+
+type Permission struct {
+	PermName      chain.Name               `json:"perm_name"`
+	Parent        chain.Name               `json:"parent"`
+	RequiredAuth  chain.Authority          `json:"required_auth"`
+	LinkedActions []PermissionLinkedAction `json:"linked_actions,omitempty"`
+}
+
+type TotalResources struct {
+	Owner     chain.Name  `json:"owner"`
+	NetWeight chain.Asset `json:"net_weight"`
+	CPUWeight chain.Asset `json:"cpu_weight"`
+	RAMBytes  chain.Int64 `json:"ram_bytes"`
+}
+
+type DelegatedBandwidth struct {
+	From      chain.Name  `json:"from"`
+	To        chain.Name  `json:"to"`
+	NetWeight chain.Asset `json:"net_weight"`
+	CPUWeight chain.Asset `json:"cpu_weight"`
+}
+
+type RefundRequest struct {
+	Owner       chain.Name  `json:"owner"`
+	RequestTime time.Time   `json:"request_time" time_format:"antelope-api" time_location:"antelope-api"`
+	NetAmount   chain.Asset `json:"net_amount"`
+	CPUAmount   chain.Asset `json:"cpu_amount"`
+}
+
+type VoterInfo struct {
+	Owner             chain.Name    `json:"owner"`
+	Proxy             chain.Name    `json:"proxy"`
+	Producers         []chain.Name  `json:"producers"`
+	Staked            chain.Int64   `json:"staked"`
+	LastVoteWeight    chain.Float64 `json:"last_vote_weight"`
+	ProxiedVoteWeight chain.Float64 `json:"proxied_vote_weight"`
+	IsProxy           uint8         `json:"is_proxy"`
+	Flags1            uint32        `json:"flags1"`
+}
+
+// Account - Struct for "/v1/chain/get_account" API
+type Account struct {
+	AccountName            chain.Name           `json:"account_name"`
+	HeadBlockNum           chain.BlockNum       `json:"head_block_num"`
+	HeadBlockTime          time.Time            `json:"head_block_time" time_format:"antelope-api" time_location:"antelope-api"`
+	Privileged             bool                 `json:"privileged"`
+	LastCodeUpdate         time.Time            `json:"last_code_update" time_format:"antelope-api" time_location:"antelope-api"`
+	Created                time.Time            `json:"created" time_format:"antelope-api" time_location:"antelope-api"`
+	CoreLiquidBalance      *chain.Asset         `json:"core_liquid_balance,omitempty"`
+	RAMQuota               chain.Int64          `json:"ram_quota"`
+	NetWeight              chain.Int64          `json:"net_weight"`
+	CPUWeight              chain.Int64          `json:"cpu_weight"`
+	NetLimit               AccountResourceLimit `json:"net_limit"`
+	CPULimit               AccountResourceLimit `json:"cpu_limit"`
+	RAMUsage               chain.Int64          `json:"ram_usage"`
+	Permissions            []Permission         `json:"permissions"`
+	TotalResources         *TotalResources      `json:"total_resources"`
+	SelfDelegatedBandwidth *DelegatedBandwidth  `json:"self_delegated_bandwidth"`
+	RefundRequest          *RefundRequest       `json:"refund_request"`
+	VoterInfo              *VoterInfo           `json:"voter_info"`
+}
+
+// Returns the permission with name, or nil if the account doesn't have it.
+func (a Account) Permission(name chain.Name) *Permission {
+	for i := range a.Permissions {
+		if a.Permissions[i].PermName == name {
+			return &a.Permissions[i]
+		}
+	}
+	return nil
+}
+
+//	GetAccount - Fetches "/v1/chain/get_account" from API
+//
+// ---------------------------------------------------------
+func (c *Client) GetAccount(ctx context.Context, name chain.Name) (account Account, err error) {
+	body := map[string]chain.Name{
+		"account_name": name,
+	}
+
+	err = c.send(ctx, "POST", "/v1/chain/get_account", body, &account)
+	return
+}
@@ -0,0 +1,210 @@
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/shufflingpixels/antelope-go/chain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAccount(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/v1/chain/get_account", req.URL.String())
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"account_name": "alice"}`, string(body))
+
+		payload := `{
+			"account_name": "alice",
+			"head_block_num": 95309425,
+			"head_block_time": "2022-12-22T13:56:03.500",
+			"privileged": false,
+			"last_code_update": "1970-01-01T00:00:00.000",
+			"created": "2018-06-10T13:04:53.000",
+			"core_liquid_balance": "12.3456 EOS",
+			"ram_quota": 5530,
+			"net_weight": "100000000000",
+			"cpu_weight": 5000,
+			"net_limit": {
+				"used": 120,
+				"available": "4294967296",
+				"max": -1,
+				"last_usage_update_time": "2022-12-22T13:56:03.000",
+				"current_used": 110
+			},
+			"cpu_limit": {
+				"used": 480,
+				"available": 1024,
+				"max": 1504,
+				"last_usage_update_time": "2022-12-22T13:56:03.000",
+				"current_used": 470
+			},
+			"ram_usage": 3574,
+			"permissions": [
+				{
+					"perm_name": "active",
+					"parent": "owner",
+					"required_auth": {
+						"threshold": 1,
+						"keys": [{"key": "EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV", "weight": 1}],
+						"accounts": [{"permission": {"actor": "bob", "permission": "eosio.code"}, "weight": 1}],
+						"waits": []
+					},
+					"linked_actions": [{"account": "eosio.token", "action": "transfer"}]
+				},
+				{
+					"perm_name": "owner",
+					"parent": "",
+					"required_auth": {
+						"threshold": 1,
+						"keys": [{"key": "PUB_K1_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5BoDq63", "weight": 1}],
+						"accounts": [],
+						"waits": [{"wait_sec": 3600, "weight": 1}]
+					}
+				}
+			],
+			"total_resources": {
+				"owner": "alice",
+				"net_weight": "1.0000 EOS",
+				"cpu_weight": "2.0000 EOS",
+				"ram_bytes": 5530
+			},
+			"self_delegated_bandwidth": {
+				"from": "alice",
+				"to": "alice",
+				"net_weight": "0.5000 EOS",
+				"cpu_weight": "1.5000 EOS"
+			},
+			"refund_request": {
+				"owner": "alice",
+				"request_time": "2022-12-20T10:00:00",
+				"net_amount": "0.1000 EOS",
+				"cpu_amount": "0.2000 EOS"
+			},
+			"voter_info": {
+				"owner": "alice",
+				"proxy": "",
+				"producers": ["eosriobrazil", "eosnationftw"],
+				"staked": 20000,
+				"last_vote_weight": "4190649434567216.50000000000000000",
+				"proxied_vote_weight": "0.00000000000000000",
+				"is_proxy": 0,
+				"flags1": 0,
+				"reserved2": 0,
+				"reserved3": "0 "
+			},
+			"rex_info": null
+		}`
+		_, _ = res.Write([]byte(payload))
+	}))
+	defer srv.Close()
+
+	client := New(srv.URL)
+
+	account, err := client.GetAccount(context.Background(), chain.N("alice"))
+	require.NoError(t, err)
+
+	assert.Equal(t, chain.N("alice"), account.AccountName)
+	assert.Equal(t, chain.BlockNum(95309425), account.HeadBlockNum)
+	assert.Equal(t, time.Date(2022, 12, 22, 13, 56, 3, 500000000, time.UTC), account.HeadBlockTime)
+	assert.Equal(t, time.Date(2018, 6, 10, 13, 4, 53, 0, time.UTC), account.Created)
+	assert.Equal(t, chain.A("12.3456 EOS"), account.CoreLiquidBalance)
+	assert.Equal(t, chain.Int64(5530), account.RAMQuota)
+	assert.Equal(t, chain.Int64(3574), account.RAMUsage)
+	assert.Equal(t, chain.Int64(100000000000), account.NetWeight)
+	assert.Equal(t, chain.Int64(5000), account.CPUWeight)
+
+	assert.Equal(t, AccountResourceLimit{
+		Used:                120,
+		Available:           4294967296,
+		Max:                 -1,
+		LastUsageUpdateTime: time.Date(2022, 12, 22, 13, 56, 3, 0, time.UTC),
+		CurrentUsed:         110,
+	}, account.NetLimit)
+	assert.Equal(t, chain.Int64(480), account.CPULimit.Used)
+	assert.Equal(t, chain.Int64(1024), account.CPULimit.Available)
+	assert.Equal(t, chain.Int64(1504), account.CPULimit.Max)
+
+	require.Len(t, account.Permissions, 2)
+	active := account.Permission(chain.N("active"))
+	require.NotNil(t, active)
+	assert.Equal(t, chain.N("owner"), active.Parent)
+	assert.Equal(t, uint32(1), active.RequiredAuth.Threshold)
+	require.Len(t, active.RequiredAuth.Keys, 1)
+	assert.Equal(t, "PUB_K1_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5BoDq63", active.RequiredAuth.Keys[0].Key.String())
+	assert.Equal(t, []chain.PermissionLevelWeight{
+		{Permission: chain.PermissionLevel{Actor: chain.N("bob"), Permission: chain.N("eosio.code")}, Weight: 1},
+	}, active.RequiredAuth.Accounts)
+	transfer := chain.N("transfer")
+	assert.Equal(t, []PermissionLinkedAction{{Account: chain.N("eosio.token"), Action: &transfer}}, active.LinkedActions)
+
+	owner := account.Permission(chain.N("owner"))
+	require.NotNil(t, owner)
+	assert.Equal(t, chain.Name(0), owner.Parent)
+	assert.Equal(t, []chain.WaitWeight{{WaitSec: 3600, Weight: 1}}, owner.RequiredAuth.Waits)
+	assert.Nil(t, account.Permission(chain.N("missing")))
+
+	assert.Equal(t, &TotalResources{
+		Owner:     chain.N("alice"),
+		NetWeight: *chain.A("1.0000 EOS"),
+		CPUWeight: *chain.A("2.0000 EOS"),
+		RAMBytes:  5530,
+	}, account.TotalResources)
+	assert.Equal(t, &DelegatedBandwidth{
+		From:      chain.N("alice"),
+		To:        chain.N("alice"),
+		NetWeight: *chain.A("0.5000 EOS"),
+		CPUWeight: *chain.A("1.5000 EOS"),
+	}, account.SelfDelegatedBandwidth)
+	assert.Equal(t, &RefundRequest{
+		Owner:       chain.N("alice"),
+		RequestTime: time.Date(2022, 12, 20, 10, 0, 0, 0, time.UTC),
+		NetAmount:   *chain.A("0.1000 EOS"),
+		CPUAmount:   *chain.A("0.2000 EOS"),
+	}, account.RefundRequest)
+
+	require.NotNil(t, account.VoterInfo)
+	assert.Equal(t, []chain.Name{chain.N("eosriobrazil"), chain.N("eosnationftw")}, account.VoterInfo.Producers)
+	assert.Equal(t, chain.Int64(20000), account.VoterInfo.Staked)
+	assert.Equal(t, chain.Float64(4190649434567216.5), account.VoterInfo.LastVoteWeight)
+	assert.Equal(t, chain.Float64(0), account.VoterInfo.ProxiedVoteWeight)
+}
+
+func TestGetAccountOptionalFields(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		payload := `{
+			"account_name": "eosio",
+			"privileged": true,
+			"ram_quota": -1,
+			"net_weight": -1,
+			"cpu_weight": -1,
+			"ram_usage": 1000,
+			"permissions": [],
+			"total_resources": null,
+			"self_delegated_bandwidth": null,
+			"refund_request": null,
+			"voter_info": null
+		}`
+		_, _ = res.Write([]byte(payload))
+	}))
+	defer srv.Close()
+
+	client := New(srv.URL)
+
+	account, err := client.GetAccount(context.Background(), chain.N("eosio"))
+	require.NoError(t, err)
+
+	assert.True(t, account.Privileged)
+	assert.Equal(t, chain.Int64(-1), account.RAMQuota)
+	assert.Nil(t, account.CoreLiquidBalance)
+	assert.Nil(t, account.TotalResources)
+	assert.Nil(t, account.SelfDelegatedBandwidth)
+	assert.Nil(t, account.RefundRequest)
+	assert.Nil(t, account.VoterInfo)
+}
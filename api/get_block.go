@@ -0,0 +1,125 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/shufflingpixels/antelope-go/chain"
+)
+
+// PackedTrx - packed transaction as included in block receipts.
+type PackedTrx struct {
+	ID chain.Checksum256 `json:"id"`
+	chain.PackedTransaction
+	ContextFreeData []chain.Bytes `json:"context_free_data"`
+}
+
+// TransactionReceiptTrx holds either the id of a transaction (deferred transactions)
+// or the full packed transaction.
+type TransactionReceiptTrx struct {
+	ID     *chain.Checksum256
+	Packed *PackedTrx
+}
+
+type TransactionReceipt struct {
+	chain.TransactionReceiptHeader
+	Trx TransactionReceiptTrx `json:"trx"`
+}
+
+// Block - Struct for "/v1/chain/get_block" API
+type Block struct {
+	chain.BlockHeader
+	ProducerSignature chain.Signature              `json:"producer_signature"`
+	Transactions      []TransactionReceipt         `json:"transactions"`
+	BlockExtensions   []chain.TransactionExtension `json:"block_extensions"`
+	ID                chain.Checksum256            `json:"id"`
+	BlockNum          chain.BlockNum               `json:"block_num"`
+	RefBlockPrefix    uint32                       `json:"ref_block_prefix"`
+}
+
+// BlockInfo - Struct for "/v1/chain/get_block_info" API
+type BlockInfo struct {
+	BlockNum          chain.BlockNum    `json:"block_num"`
+	RefBlockNum       uint16            `json:"ref_block_num"`
+	ID                chain.Checksum256 `json:"id"`
+	Timestamp         time.Time         `json:"timestamp" time_format:"antelope-api" time_location:"antelope-api"`
+	Producer          chain.Name        `json:"producer"`
+	Confirmed         uint16            `json:"confirmed"`
+	Previous          chain.Checksum256 `json:"previous"`
+	TransactionMRoot  chain.Checksum256 `json:"transaction_mroot"`
+	ActionMRoot       chain.Checksum256 `json:"action_mroot"`
+	ScheduleVersion   uint32            `json:"schedule_version"`
+	ProducerSignature chain.Signature   `json:"producer_signature"`
+	RefBlockPrefix    uint32            `json:"ref_block_prefix"`
+}
+
+// Returns the transaction id of the receipt.
+func (r TransactionReceiptTrx) TransactionID() chain.Checksum256 {
+	if r.Packed != nil {
+		return r.Packed.ID
+	}
+	if r.ID != nil {
+		return *r.ID
+	}
+	return chain.Checksum256{}
+}
+
+//	GetBlock - Fetches "/v1/chain/get_block" from API
+//
+// ---------------------------------------------------------
+func (c *Client) GetBlock(ctx context.Context, numOrID string) (block Block, err error) {
+	body := map[string]string{
+		"block_num_or_id": numOrID,
+	}
+
+	err = c.send(ctx, "POST", "/v1/chain/get_block", body, &block)
+	return
+}
+
+//	GetBlockInfo - Fetches "/v1/chain/get_block_info" from API
+//
+// ---------------------------------------------------------
+func (c *Client) GetBlockInfo(ctx context.Context, num chain.BlockNum) (info BlockInfo, err error) {
+	body := map[string]chain.BlockNum{
+		"block_num": num,
+	}
+
+	err = c.send(ctx, "POST", "/v1/chain/get_block_info", body, &info)
+	return
+}
+
+// json.Marshaler conformance
+
+func (r TransactionReceiptTrx) MarshalJSON() ([]byte, error) {
+	if r.Packed != nil {
+		return json.Marshal(r.Packed)
+	}
+	return json.Marshal(r.ID)
+}
+
+// json.Unmarshaler conformance
+
+// nodeos returns either the id as a string or the packed transaction as an object.
+// Older versions encode it as a [index, value] variant, so accept that too.
+func (r *TransactionReceiptTrx) UnmarshalJSON(b []byte) error {
+	if len(b) > 0 && b[0] == '[' {
+		var variant []jsoniter.RawMessage
+		if err := json.Unmarshal(b, &variant); err != nil {
+			return err
+		}
+		if len(variant) != 2 {
+			return errors.New("invalid transaction receipt variant")
+		}
+		b = variant[1]
+	}
+
+	r.ID, r.Packed = nil, nil
+	if len(b) > 0 && b[0] == '"' {
+		r.ID = &chain.Checksum256{}
+		return json.Unmarshal(b, r.ID)
+	}
+	r.Packed = &PackedTrx{}
+	return json.Unmarshal(b, r.Packed)
+}
@@ -0,0 +1,158 @@
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/shufflingpixels/antelope-go/chain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBlock(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/v1/chain/get_block", req.URL.String())
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"block_num_or_id": "95309425"}`, string(body))
+
+		payload := `{
+			"timestamp": "2022-12-22T13:56:03.500",
+			"producer": "eosriobrazil",
+			"confirmed": 0,
+			"previous": "05ae4e7033dc0138bb3bede566d57cc7783ba1a091388c6961e93c0ef37476dc",
+			"transaction_mroot": "a2256be7d32e4d3a7c6c2e1bbd4a1a1b0ab7dbfcae8d03e5b7fd0f1f1b3d3d49",
+			"action_mroot": "7f84ff0d833c5965f73fb9651881c5b233ab07b45b1a2646b82946f66b78ff92",
+			"schedule_version": 2048,
+			"new_producers": null,
+			"producer_signature": "SIG_K1_KVGMr8LbsJrMS5hPG6xhhsAWZ8BGmcEhYN6iGFQiz8piBSR4fZcu1NqAX75STz6V99LSDomx4jo4bVxrGTW4FNbeRBNY1R",
+			"header_extensions": [[1, "beef"]],
+			"transactions": [
+				{
+					"status": "executed",
+					"cpu_usage_us": 181,
+					"net_usage_words": 16,
+					"trx": {
+						"id": "ff6dc6a9ee3a4f1bef38bc36b8e1c2a3a8f47df4e4bf39e0b5f4b3e9db4a8c71",
+						"signatures": [
+							"SIG_K1_KVGMr8LbsJrMS5hPG6xhhsAWZ8BGmcEhYN6iGFQiz8piBSR4fZcu1NqAX75STz6V99LSDomx4jo4bVxrGTW4FNbeRBNY1R"
+						],
+						"compression": "none",
+						"packed_context_free_data": "",
+						"context_free_data": [],
+						"packed_trx": "d20296490b0016000000212c370001000000000000285d000000000000ae39000000",
+						"transaction": {
+							"expiration": "2009-02-13T23:31:30",
+							"actions": []
+						}
+					}
+				},
+				{
+					"status": "soft_fail",
+					"cpu_usage_us": 100,
+					"net_usage_words": 0,
+					"trx": "7f84ff0d833c5965f73fb9651881c5b233ab07b45b1a2646b82946f66b78ff92"
+				},
+				{
+					"status": "expired",
+					"cpu_usage_us": 0,
+					"net_usage_words": 0,
+					"trx": [0, "a2256be7d32e4d3a7c6c2e1bbd4a1a1b0ab7dbfcae8d03e5b7fd0f1f1b3d3d49"]
+				}
+			],
+			"block_extensions": [],
+			"id": "05ae4e71c1f9a0c1bb6f6e2c0ab3fbd5b1c6a0c1f8a1f2e3d4c5b6a798a9b0c1",
+			"block_num": 95309425,
+			"ref_block_prefix": 3589504955
+		}`
+		_, _ = res.Write([]byte(payload))
+	}))
+	defer srv.Close()
+
+	client := New(srv.URL)
+
+	block, err := client.GetBlock(context.Background(), "95309425")
+	require.NoError(t, err)
+
+	assert.Equal(t, time.Date(2022, 12, 22, 13, 56, 3, 500000000, time.UTC), block.Timestamp.Time().UTC())
+	assert.Equal(t, chain.N("eosriobrazil"), block.Producer)
+	assert.Equal(t, "05ae4e7033dc0138bb3bede566d57cc7783ba1a091388c6961e93c0ef37476dc", block.Previous.String())
+	assert.Equal(t, uint32(2048), block.ScheduleVersion)
+	assert.Nil(t, block.NewProducersV1)
+	assert.Equal(t, []chain.TransactionExtension{{Type: 1, Data: chain.Bytes{0xbe, 0xef}}}, block.HeaderExtensions)
+	assert.Equal(t, chain.K1, block.ProducerSignature.Type)
+	assert.Equal(t, "05ae4e71c1f9a0c1bb6f6e2c0ab3fbd5b1c6a0c1f8a1f2e3d4c5b6a798a9b0c1", block.ID.String())
+	assert.Equal(t, chain.BlockNum(95309425), block.BlockNum)
+	assert.Equal(t, uint32(3589504955), block.RefBlockPrefix)
+	assert.Equal(t, []chain.TransactionExtension{}, block.BlockExtensions)
+	require.Len(t, block.Transactions, 3)
+
+	receipt := block.Transactions[0]
+	assert.Equal(t, chain.TransactionStatusExecuted, receipt.Status)
+	assert.Equal(t, uint32(181), receipt.CPUUsageMicroSeconds)
+	assert.Equal(t, uint(16), receipt.NetUsageWords)
+	assert.Nil(t, receipt.Trx.ID)
+	require.NotNil(t, receipt.Trx.Packed)
+	assert.Equal(t, "ff6dc6a9ee3a4f1bef38bc36b8e1c2a3a8f47df4e4bf39e0b5f4b3e9db4a8c71", receipt.Trx.TransactionID().String())
+	assert.Equal(t, chain.CompressionNone, receipt.Trx.Packed.Compression)
+	assert.Len(t, receipt.Trx.Packed.Signatures, 1)
+
+	tx, err := receipt.Trx.Packed.Unpack()
+	require.NoError(t, err)
+	require.Len(t, tx.Actions, 1)
+	assert.Equal(t, chain.N("foo"), tx.Actions[0].Account)
+
+	receipt = block.Transactions[1]
+	assert.Equal(t, chain.TransactionStatusSoftFail, receipt.Status)
+	assert.Nil(t, receipt.Trx.Packed)
+	require.NotNil(t, receipt.Trx.ID)
+	assert.Equal(t, "7f84ff0d833c5965f73fb9651881c5b233ab07b45b1a2646b82946f66b78ff92", receipt.Trx.TransactionID().String())
+
+	receipt = block.Transactions[2]
+	assert.Equal(t, chain.TransactionStatusExpired, receipt.Status)
+	assert.Equal(t, "a2256be7d32e4d3a7c6c2e1bbd4a1a1b0ab7dbfcae8d03e5b7fd0f1f1b3d3d49", receipt.Trx.TransactionID().String())
+}
+
+func TestGetBlockInfo(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/v1/chain/get_block_info", req.URL.String())
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"block_num": 95309425}`, string(body))
+
+		payload := `{
+			"block_num": 95309425,
+			"ref_block_num": 20081,
+			"id": "05ae4e71c1f9a0c1bb6f6e2c0ab3fbd5b1c6a0c1f8a1f2e3d4c5b6a798a9b0c1",
+			"timestamp": "2022-12-22T13:56:03.500",
+			"producer": "eosriobrazil",
+			"confirmed": 0,
+			"previous": "05ae4e7033dc0138bb3bede566d57cc7783ba1a091388c6961e93c0ef37476dc",
+			"transaction_mroot": "a2256be7d32e4d3a7c6c2e1bbd4a1a1b0ab7dbfcae8d03e5b7fd0f1f1b3d3d49",
+			"action_mroot": "7f84ff0d833c5965f73fb9651881c5b233ab07b45b1a2646b82946f66b78ff92",
+			"schedule_version": 2048,
+			"producer_signature": "SIG_K1_KVGMr8LbsJrMS5hPG6xhhsAWZ8BGmcEhYN6iGFQiz8piBSR4fZcu1NqAX75STz6V99LSDomx4jo4bVxrGTW4FNbeRBNY1R",
+			"ref_block_prefix": 3589504955
+		}`
+		_, _ = res.Write([]byte(payload))
+	}))
+	defer srv.Close()
+
+	client := New(srv.URL)
+
+	info, err := client.GetBlockInfo(context.Background(), 95309425)
+	require.NoError(t, err)
+
+	assert.Equal(t, chain.BlockNum(95309425), info.BlockNum)
+	assert.Equal(t, uint16(20081), info.RefBlockNum)
+	assert.Equal(t, "05ae4e71c1f9a0c1bb6f6e2c0ab3fbd5b1c6a0c1f8a1f2e3d4c5b6a798a9b0c1", info.ID.String())
+	assert.Equal(t, time.Date(2022, 12, 22, 13, 56, 3, 500000000, time.UTC), info.Timestamp)
+	assert.Equal(t, chain.N("eosriobrazil"), info.Producer)
+	assert.Equal(t, uint32(2048), info.ScheduleVersion)
+	assert.Equal(t, chain.K1, info.ProducerSignature.Type)
+	assert.Equal(t, uint32(3589504955), info.RefBlockPrefix)
+}
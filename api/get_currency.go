@@ -0,0 +1,63 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shufflingpixels/antelope-go/chain"
+)
+
+// CurrencyStats - Struct for "/v1/chain/get_currency_stats" API
+type CurrencyStats struct {
+	Supply    chain.Asset `json:"supply"`
+	MaxSupply chain.Asset `json:"max_supply"`
+	Issuer    chain.Name  `json:"issuer"`
+}
+
+// Symbol of the currency.
+func (s CurrencyStats) Symbol() chain.Symbol {
+	return s.Supply.Symbol
+}
+
+//	GetCurrencyBalance - Fetches "/v1/chain/get_currency_balance" from API
+//
+// If symbol is zero, balances of all symbols in the contract are returned.
+//
+// ---------------------------------------------------------
+func (c *Client) GetCurrencyBalance(ctx context.Context, code chain.Name, account chain.Name, symbol chain.SymbolCode) (balances []chain.Asset, err error) {
+	body := map[string]interface{}{
+		"code":    code,
+		"account": account,
+	}
+	if symbol != 0 {
+		body["symbol"] = symbol
+	}
+
+	err = c.send(ctx, "POST", "/v1/chain/get_currency_balance", body, &balances)
+	return
+}
+
+//	GetCurrencyStats - Fetches "/v1/chain/get_currency_stats" from API
+//
+// ---------------------------------------------------------
+func (c *Client) GetCurrencyStats(ctx context.Context, code chain.Name, symbol chain.SymbolCode) (stats CurrencyStats, err error) {
+	body := map[string]interface{}{
+		"code":   code,
+		"symbol": symbol,
+	}
+
+	var resp map[string]CurrencyStats
+	err = c.send(ctx, "POST", "/v1/chain/get_currency_stats", body, &resp)
+	if err != nil {
+		return
+	}
+
+	for _, s := range resp {
+		if s.Supply.Code() == symbol {
+			return s, nil
+		}
+	}
+	text, _ := symbol.MarshalText()
+	err = fmt.Errorf("currency stats for %s not found", text)
+	return
+}
@@ -0,0 +1,82 @@
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/shufflingpixels/antelope-go/chain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func symbolCode(t *testing.T, s string) chain.SymbolCode {
+	var sc chain.SymbolCode
+	require.NoError(t, sc.UnmarshalText([]byte(s)))
+	return sc
+}
+
+func TestGetCurrencyBalance(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/v1/chain/get_currency_balance", req.URL.String())
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"code": "eosio.token", "account": "alice", "symbol": "EOS"}`, string(body))
+		_, _ = res.Write([]byte(`["12.3456 EOS"]`))
+	}))
+	defer srv.Close()
+
+	balances, err := New(srv.URL).GetCurrencyBalance(context.Background(), chain.N("eosio.token"), chain.N("alice"), symbolCode(t, "EOS"))
+	require.NoError(t, err)
+	assert.Equal(t, []chain.Asset{*chain.A("12.3456 EOS")}, balances)
+}
+
+func TestGetCurrencyBalanceAllSymbols(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"code": "eosio.token", "account": "alice"}`, string(body))
+		_, _ = res.Write([]byte(`["12.3456 EOS", "100.00 FOO"]`))
+	}))
+	defer srv.Close()
+
+	balances, err := New(srv.URL).GetCurrencyBalance(context.Background(), chain.N("eosio.token"), chain.N("alice"), 0)
+	require.NoError(t, err)
+	assert.Equal(t, []chain.Asset{*chain.A("12.3456 EOS"), *chain.A("100.00 FOO")}, balances)
+}
+
+func TestGetCurrencyStats(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/v1/chain/get_currency_stats", req.URL.String())
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"code": "eosio.token", "symbol": "EOS"}`, string(body))
+		_, _ = res.Write([]byte(`{
+			"EOS": {
+				"supply": "1085126527.9322 EOS",
+				"max_supply": "10000000000.0000 EOS",
+				"issuer": "eosio"
+			}
+		}`))
+	}))
+	defer srv.Close()
+
+	stats, err := New(srv.URL).GetCurrencyStats(context.Background(), chain.N("eosio.token"), symbolCode(t, "EOS"))
+	require.NoError(t, err)
+	assert.Equal(t, *chain.A("1085126527.9322 EOS"), stats.Supply)
+	assert.Equal(t, *chain.A("10000000000.0000 EOS"), stats.MaxSupply)
+	assert.Equal(t, chain.N("eosio"), stats.Issuer)
+	assert.Equal(t, "4,EOS", stats.Symbol().String())
+}
+
+func TestGetCurrencyStatsNotFound(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		_, _ = res.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	_, err := New(srv.URL).GetCurrencyStats(context.Background(), chain.N("eosio.token"), symbolCode(t, "FOO"))
+	assert.EqualError(t, err, "currency stats for FOO not found")
+}
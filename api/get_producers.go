@@ -0,0 +1,175 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/shufflingpixels/antelope-go/chain"
+)
+
+// Producer - Row in "/v1/chain/get_producers" API
+type Producer struct {
+	Owner             chain.Name                   `json:"owner"`
+	TotalVotes        chain.Float64                `json:"total_votes"`
+	ProducerKey       chain.PublicKey              `json:"producer_key"`
+	IsActive          uint8                        `json:"is_active"`
+	URL               string                       `json:"url"`
+	UnpaidBlocks      uint32                       `json:"unpaid_blocks"`
+	LastClaimTime     time.Time                    `json:"last_claim_time" time_format:"antelope-api" time_location:"antelope-api"`
+	Location          uint16                       `json:"location"`
+	ProducerAuthority *chain.BlockSigningAuthority `json:"producer_authority,omitempty"`
+}
+
+// Returns true if the producer is registered and active.
+func (p Producer) Active() bool {
+	return p.IsActive != 0
+}
+
+// ProducersResp - Struct for "/v1/chain/get_producers" API
+type ProducersResp struct {
+	Rows                    []Producer    `json:"rows"`
+	TotalProducerVoteWeight chain.Float64 `json:"total_producer_vote_weight"`
+	// Lower bound to use for the next page, empty if there are no more rows.
+	More string `json:"more"`
+}
+
+// Producer schedule, nodeos returns the legacy schedule (v1) before the
+// WTMSIG_BLOCK_SIGNATURES feature is activated and the authority based one (v2) after.
+type Schedule struct {
+	V1 *chain.ProducerSchedule
+	V2 *chain.ProducerAuthoritySchedule
+}
+
+// ProducerScheduleResp - Struct for "/v1/chain/get_producer_schedule" API
+type ProducerScheduleResp struct {
+	Active   *Schedule `json:"active"`
+	Pending  *Schedule `json:"pending"`
+	Proposed *Schedule `json:"proposed"`
+}
+
+// Version of the schedule.
+func (s Schedule) Version() uint32 {
+	if s.V1 != nil {
+		return s.V1.Version
+	}
+	if s.V2 != nil {
+		return s.V2.Version
+	}
+	return 0
+}
+
+// Names of the producers in the schedule.
+func (s Schedule) Producers() []chain.Name {
+	names := []chain.Name{}
+	if s.V1 != nil {
+		for _, p := range s.V1.Producers {
+			names = append(names, p.AccountName)
+		}
+	}
+	if s.V2 != nil {
+		for _, p := range s.V2.Producers {
+			names = append(names, p.AccountName)
+		}
+	}
+	return names
+}
+
+// json.Marshaler conformance
+
+func (s Schedule) MarshalJSON() ([]byte, error) {
+	if s.V1 != nil {
+		return json.Marshal(s.V1)
+	}
+	return json.Marshal(s.V2)
+}
+
+// json.Unmarshaler conformance
+
+func (s *Schedule) UnmarshalJSON(b []byte) error {
+	// Only the legacy schedule has "block_signing_key" in the producer objects.
+	if bytes.Contains(b, []byte(`"block_signing_key"`)) {
+		s.V1 = &chain.ProducerSchedule{}
+		return json.Unmarshal(b, s.V1)
+	}
+	s.V2 = &chain.ProducerAuthoritySchedule{}
+	return json.Unmarshal(b, s.V2)
+}
+
+type ProtocolFeatureSpecification struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// ActivatedProtocolFeature - Row in "/v1/chain/get_activated_protocol_features" API
+type ActivatedProtocolFeature struct {
+	FeatureDigest       chain.Checksum256              `json:"feature_digest"`
+	ActivationOrdinal   uint32                         `json:"activation_ordinal"`
+	ActivationBlockNum  uint32                         `json:"activation_block_num"`
+	DescriptionDigest   chain.Checksum256              `json:"description_digest"`
+	Dependencies        []chain.Checksum256            `json:"dependencies"`
+	ProtocolFeatureType string                         `json:"protocol_feature_type"`
+	Specification       []ProtocolFeatureSpecification `json:"specification"`
+}
+
+// Codename of a builtin protocol feature (for example "ONLY_BILL_FIRST_AUTHORIZER")
+// empty string if the feature has no codename.
+func (f ActivatedProtocolFeature) Codename() string {
+	for _, spec := range f.Specification {
+		if spec.Name == "builtin_feature_codename" {
+			return spec.Value
+		}
+	}
+	return ""
+}
+
+type ActivatedProtocolFeaturesReq struct {
+	LowerBound *uint32 `json:"lower_bound,omitempty"`
+	UpperBound *uint32 `json:"upper_bound,omitempty"`
+	Limit      uint32  `json:"limit,omitempty"`
+	// Bounds are block numbers instead of activation ordinals.
+	SearchByBlockNum bool `json:"search_by_block_num"`
+	Reverse          bool `json:"reverse"`
+}
+
+// ActivatedProtocolFeaturesResp - Struct for "/v1/chain/get_activated_protocol_features" API
+type ActivatedProtocolFeaturesResp struct {
+	ActivatedProtocolFeatures []ActivatedProtocolFeature `json:"activated_protocol_features"`
+	// Lower bound (or upper bound if reversed) to use for the next page, nil if there are no more rows.
+	More *uint32 `json:"more,omitempty"`
+}
+
+//	GetProducers - Fetches "/v1/chain/get_producers" from API
+//
+// Rows are ordered by total votes, pass ProducersResp.More as
+// lowerBound to fetch the next page. A limit of zero uses the nodeos default.
+//
+// ---------------------------------------------------------
+func (c *Client) GetProducers(ctx context.Context, lowerBound string, limit uint32) (resp ProducersResp, err error) {
+	body := map[string]interface{}{
+		"json":        true,
+		"lower_bound": lowerBound,
+	}
+	if limit > 0 {
+		body["limit"] = limit
+	}
+
+	err = c.send(ctx, "POST", "/v1/chain/get_producers", body, &resp)
+	return
+}
+
+//	GetProducerSchedule - Fetches "/v1/chain/get_producer_schedule" from API
+//
+// ---------------------------------------------------------
+func (c *Client) GetProducerSchedule(ctx context.Context) (resp ProducerScheduleResp, err error) {
+	err = c.send(ctx, "POST", "/v1/chain/get_producer_schedule", map[string]interface{}{}, &resp)
+	return
+}
+
+//	GetActivatedProtocolFeatures - Fetches "/v1/chain/get_activated_protocol_features" from API
+//
+// ---------------------------------------------------------
+func (c *Client) GetActivatedProtocolFeatures(ctx context.Context, req ActivatedProtocolFeaturesReq) (resp ActivatedProtocolFeaturesResp, err error) {
+	err = c.send(ctx, "POST", "/v1/chain/get_activated_protocol_features", req, &resp)
+	return
+}
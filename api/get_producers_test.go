@@ -0,0 +1,202 @@
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/shufflingpixels/antelope-go/chain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetProducers(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/v1/chain/get_producers", req.URL.String())
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"json": true, "lower_bound": "", "limit": 2}`, string(body))
+		_, _ = res.Write([]byte(`{
+			"rows": [
+				{
+					"owner": "alice",
+					"total_votes": "2415733718932785152.00000000000000000",
+					"producer_key": "PUB_K1_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5BoDq63",
+					"is_active": 1,
+					"url": "https://alice.example",
+					"unpaid_blocks": 120,
+					"last_claim_time": "2024-02-01T12:34:56.500",
+					"location": 840,
+					"producer_authority": [
+						"block_signing_authority_v0",
+						{
+							"threshold": 1,
+							"keys": [{"key": "PUB_K1_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5BoDq63", "weight": 1}]
+						}
+					]
+				},
+				{
+					"owner": "bob",
+					"total_votes": "0.00000000000000000",
+					"producer_key": "PUB_K1_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5BoDq63",
+					"is_active": 0,
+					"url": "",
+					"unpaid_blocks": 0,
+					"last_claim_time": "1970-01-01T00:00:00.000",
+					"location": 0
+				}
+			],
+			"total_producer_vote_weight": "56193718243245629440.00000000000000000",
+			"more": "carol"
+		}`))
+	}))
+	defer srv.Close()
+
+	resp, err := New(srv.URL).GetProducers(context.Background(), "", 2)
+	require.NoError(t, err)
+	require.Len(t, resp.Rows, 2)
+	assert.Equal(t, "carol", resp.More)
+	assert.Equal(t, chain.Float64(56193718243245629440), resp.TotalProducerVoteWeight)
+
+	alice := resp.Rows[0]
+	assert.Equal(t, chain.N("alice"), alice.Owner)
+	assert.Equal(t, chain.Float64(2415733718932785152), alice.TotalVotes)
+	assert.True(t, alice.Active())
+	assert.Equal(t, uint32(120), alice.UnpaidBlocks)
+	assert.Equal(t, time.Date(2024, 2, 1, 12, 34, 56, 500000000, time.UTC), alice.LastClaimTime)
+	assert.Equal(t, uint16(840), alice.Location)
+	require.NotNil(t, alice.ProducerAuthority)
+	require.NotNil(t, alice.ProducerAuthority.V0)
+	assert.Equal(t, uint32(1), alice.ProducerAuthority.V0.Threshold)
+	assert.Equal(t, alice.ProducerKey, alice.ProducerAuthority.V0.Keys[0].Key)
+
+	bob := resp.Rows[1]
+	assert.False(t, bob.Active())
+	assert.Nil(t, bob.ProducerAuthority)
+}
+
+func TestGetProducersDefaultLimit(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"json": true, "lower_bound": ""}`, string(body))
+		_, _ = res.Write([]byte(`{"rows": [], "total_producer_vote_weight": "0.00000000000000000", "more": ""}`))
+	}))
+	defer srv.Close()
+
+	_, err := New(srv.URL).GetProducers(context.Background(), "", 0)
+	require.NoError(t, err)
+}
+
+func TestGetProducerSchedule(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/v1/chain/get_producer_schedule", req.URL.String())
+		_, _ = res.Write([]byte(`{
+			"active": {
+				"version": 3,
+				"producers": [
+					{
+						"producer_name": "alice",
+						"authority": [0, {
+							"threshold": 1,
+							"keys": [{"key": "PUB_K1_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5BoDq63", "weight": 1}]
+						}]
+					},
+					{
+						"producer_name": "bob",
+						"authority": [0, {
+							"threshold": 1,
+							"keys": [{"key": "PUB_K1_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5BoDq63", "weight": 1}]
+						}]
+					}
+				]
+			},
+			"pending": null,
+			"proposed": {
+				"version": 4,
+				"producers": []
+			}
+		}`))
+	}))
+	defer srv.Close()
+
+	resp, err := New(srv.URL).GetProducerSchedule(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, resp.Active)
+	require.NotNil(t, resp.Active.V2)
+	assert.Nil(t, resp.Active.V1)
+	assert.Equal(t, uint32(3), resp.Active.Version())
+	assert.Equal(t, []chain.Name{chain.N("alice"), chain.N("bob")}, resp.Active.Producers())
+	assert.Nil(t, resp.Pending)
+	require.NotNil(t, resp.Proposed)
+	assert.Equal(t, uint32(4), resp.Proposed.Version())
+	assert.Empty(t, resp.Proposed.Producers())
+}
+
+func TestGetProducerScheduleLegacy(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		_, _ = res.Write([]byte(`{
+			"active": {
+				"version": 1,
+				"producers": [
+					{"producer_name": "alice", "block_signing_key": "PUB_K1_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5BoDq63"}
+				]
+			},
+			"pending": null,
+			"proposed": null
+		}`))
+	}))
+	defer srv.Close()
+
+	resp, err := New(srv.URL).GetProducerSchedule(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, resp.Active)
+	require.NotNil(t, resp.Active.V1)
+	assert.Nil(t, resp.Active.V2)
+	assert.Equal(t, uint32(1), resp.Active.Version())
+	assert.Equal(t, chain.N("alice"), resp.Active.V1.Producers[0].AccountName)
+	assert.Equal(t, "PUB_K1_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5BoDq63", resp.Active.V1.Producers[0].BlockSigningKey.String())
+}
+
+func TestGetActivatedProtocolFeatures(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/v1/chain/get_activated_protocol_features", req.URL.String())
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"lower_bound": 0, "limit": 1, "search_by_block_num": false, "reverse": false}`, string(body))
+		_, _ = res.Write([]byte(`{
+			"activated_protocol_features": [
+				{
+					"feature_digest": "0ec7e080177b2c02b278d5088611686b49d739925a92d9bfcacd7fc6b74053bd",
+					"activation_ordinal": 0,
+					"activation_block_num": 4,
+					"description_digest": "64fe7df32e9b86be2b296b3f81dfd527f84e82b98e363bc97e40bc7a83733310",
+					"dependencies": [],
+					"protocol_feature_type": "builtin",
+					"specification": [
+						{"name": "builtin_feature_codename", "value": "PREACTIVATE_FEATURE"}
+					]
+				}
+			],
+			"more": 1
+		}`))
+	}))
+	defer srv.Close()
+
+	lower := uint32(0)
+	resp, err := New(srv.URL).GetActivatedProtocolFeatures(context.Background(), ActivatedProtocolFeaturesReq{
+		LowerBound: &lower,
+		Limit:      1,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.ActivatedProtocolFeatures, 1)
+	feature := resp.ActivatedProtocolFeatures[0]
+	assert.Equal(t, "0ec7e080177b2c02b278d5088611686b49d739925a92d9bfcacd7fc6b74053bd", feature.FeatureDigest.String())
+	assert.Equal(t, uint32(4), feature.ActivationBlockNum)
+	assert.Equal(t, "PREACTIVATE_FEATURE", feature.Codename())
+	require.NotNil(t, resp.More)
+	assert.Equal(t, uint32(1), *resp.More)
+}
@@ -0,0 +1,90 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"github.com/shufflingpixels/antelope-go/chain"
+)
+
+var ErrAbiHashMismatch = errors.New("abi does not match abi_hash")
+
+// RawAbiResp - Struct for "/v1/chain/get_raw_abi" API
+type RawAbiResp struct {
+	AccountName chain.Name        `json:"account_name"`
+	CodeHash    chain.Checksum256 `json:"code_hash"`
+	AbiHash     chain.Checksum256 `json:"abi_hash"`
+	RawAbi      chain.Blob        `json:"abi"`
+	// Decoded abi, nil if the account has no abi or it was not returned.
+	Abi *chain.Abi `json:"-"`
+}
+
+// RawCodeAndAbiResp - Struct for "/v1/chain/get_raw_code_and_abi" API
+type RawCodeAndAbiResp struct {
+	AccountName chain.Name `json:"account_name"`
+	Wasm        chain.Blob `json:"wasm"`
+	RawAbi      chain.Blob `json:"abi"`
+	// Decoded abi, nil if the account has no abi.
+	Abi *chain.Abi `json:"-"`
+}
+
+//	GetRawAbi - Fetches "/v1/chain/get_raw_abi" from API
+//
+// ---------------------------------------------------------
+func (c *Client) GetRawAbi(ctx context.Context, account chain.Name) (RawAbiResp, error) {
+	return c.getRawAbi(ctx, map[string]interface{}{
+		"account_name": account,
+	})
+}
+
+//	GetRawAbiIfChanged - Fetches "/v1/chain/get_raw_abi" from API
+//
+// The abi is only returned if it's hash differs from abiHash,
+// otherwise RawAbi and Abi is left empty.
+//
+// ---------------------------------------------------------
+func (c *Client) GetRawAbiIfChanged(ctx context.Context, account chain.Name, abiHash chain.Checksum256) (RawAbiResp, error) {
+	return c.getRawAbi(ctx, map[string]interface{}{
+		"account_name": account,
+		"abi_hash":     abiHash,
+	})
+}
+
+func (c *Client) getRawAbi(ctx context.Context, body map[string]interface{}) (resp RawAbiResp, err error) {
+	err = c.send(ctx, "POST", "/v1/chain/get_raw_abi", body, &resp)
+	if err != nil || len(resp.RawAbi) < 1 {
+		return
+	}
+	if chain.Checksum256Digest(resp.RawAbi) != resp.AbiHash {
+		err = ErrAbiHashMismatch
+		return
+	}
+	resp.Abi, err = decodeRawAbi(resp.RawAbi)
+	return
+}
+
+//	GetRawCodeAndAbi - Fetches "/v1/chain/get_raw_code_and_abi" from API
+//
+// ---------------------------------------------------------
+func (c *Client) GetRawCodeAndAbi(ctx context.Context, account chain.Name) (resp RawCodeAndAbiResp, err error) {
+	body := map[string]interface{}{
+		"account_name": account,
+	}
+
+	err = c.send(ctx, "POST", "/v1/chain/get_raw_code_and_abi", body, &resp)
+	if err != nil || len(resp.RawAbi) < 1 {
+		return
+	}
+	resp.Abi, err = decodeRawAbi(resp.RawAbi)
+	return
+}
+
+func decodeRawAbi(data []byte) (*chain.Abi, error) {
+	abi := &chain.Abi{}
+	err := chain.NewDecoder(bytes.NewReader(data)).Decode(abi)
+	if err != nil {
+		return nil, err
+	}
+	return abi, nil
+}
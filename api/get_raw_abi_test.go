@@ -0,0 +1,120 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/shufflingpixels/antelope-go/chain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeAbi(t *testing.T, abi chain.Abi) []byte {
+	b := bytes.NewBuffer(nil)
+	require.NoError(t, chain.NewEncoder(b).Encode(abi))
+	return b.Bytes()
+}
+
+func TestGetRawAbi(t *testing.T) {
+	raw := encodeAbi(t, accountsAbi)
+	hash := chain.Checksum256Digest(raw)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/v1/chain/get_raw_abi", req.URL.String())
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"account_name": "eosio.token"}`, string(body))
+
+		payload := fmt.Sprintf(`{
+			"account_name": "eosio.token",
+			"code_hash": "a2256be7d32e4d3a7c6c2e1bbd4a1a1b0ab7dbfcae8d03e5b7fd0f1f1b3d3d49",
+			"abi_hash": "%s",
+			"abi": "%s"
+		}`, hash, base64.StdEncoding.EncodeToString(raw))
+		_, _ = res.Write([]byte(payload))
+	}))
+	defer srv.Close()
+
+	resp, err := New(srv.URL).GetRawAbi(context.Background(), chain.N("eosio.token"))
+	require.NoError(t, err)
+	assert.Equal(t, chain.N("eosio.token"), resp.AccountName)
+	assert.Equal(t, "a2256be7d32e4d3a7c6c2e1bbd4a1a1b0ab7dbfcae8d03e5b7fd0f1f1b3d3d49", resp.CodeHash.String())
+	assert.Equal(t, hash, resp.AbiHash)
+	assert.Equal(t, chain.Blob(raw), resp.RawAbi)
+	require.NotNil(t, resp.Abi)
+	assert.Equal(t, "account", resp.Abi.GetTable("accounts").Type)
+}
+
+func TestGetRawAbiHashMismatch(t *testing.T) {
+	raw := encodeAbi(t, accountsAbi)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		payload := fmt.Sprintf(`{
+			"account_name": "eosio.token",
+			"code_hash": "a2256be7d32e4d3a7c6c2e1bbd4a1a1b0ab7dbfcae8d03e5b7fd0f1f1b3d3d49",
+			"abi_hash": "a2256be7d32e4d3a7c6c2e1bbd4a1a1b0ab7dbfcae8d03e5b7fd0f1f1b3d3d49",
+			"abi": "%s"
+		}`, base64.StdEncoding.EncodeToString(raw))
+		_, _ = res.Write([]byte(payload))
+	}))
+	defer srv.Close()
+
+	_, err := New(srv.URL).GetRawAbi(context.Background(), chain.N("eosio.token"))
+	assert.Equal(t, ErrAbiHashMismatch, err)
+}
+
+func TestGetRawAbiIfChanged(t *testing.T) {
+	hash := chain.Checksum256Digest(encodeAbi(t, accountsAbi))
+
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, fmt.Sprintf(`{"account_name": "eosio.token", "abi_hash": "%s"}`, hash), string(body))
+
+		// nodeos leaves out the abi if the hash matches.
+		payload := fmt.Sprintf(`{
+			"account_name": "eosio.token",
+			"code_hash": "a2256be7d32e4d3a7c6c2e1bbd4a1a1b0ab7dbfcae8d03e5b7fd0f1f1b3d3d49",
+			"abi_hash": "%s"
+		}`, hash)
+		_, _ = res.Write([]byte(payload))
+	}))
+	defer srv.Close()
+
+	resp, err := New(srv.URL).GetRawAbiIfChanged(context.Background(), chain.N("eosio.token"), hash)
+	require.NoError(t, err)
+	assert.Equal(t, hash, resp.AbiHash)
+	assert.Empty(t, resp.RawAbi)
+	assert.Nil(t, resp.Abi)
+}
+
+func TestGetRawCodeAndAbi(t *testing.T) {
+	raw := encodeAbi(t, accountsAbi)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/v1/chain/get_raw_code_and_abi", req.URL.String())
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"account_name": "eosio.token"}`, string(body))
+
+		payload := fmt.Sprintf(`{
+			"account_name": "eosio.token",
+			"wasm": "AGFzbQEAAAA=",
+			"abi": "%s"
+		}`, base64.StdEncoding.EncodeToString(raw))
+		_, _ = res.Write([]byte(payload))
+	}))
+	defer srv.Close()
+
+	resp, err := New(srv.URL).GetRawCodeAndAbi(context.Background(), chain.N("eosio.token"))
+	require.NoError(t, err)
+	assert.Equal(t, chain.Blob{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}, resp.Wasm)
+	require.NotNil(t, resp.Abi)
+	assert.Equal(t, "eosio::abi/1.1", resp.Abi.Version)
+}
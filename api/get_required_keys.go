@@ -0,0 +1,36 @@
+package api
+
+import (
+	"context"
+
+	"github.com/shufflingpixels/antelope-go/chain"
+)
+
+type getRequiredKeysReq struct {
+	Transaction   chain.Transaction `json:"transaction"`
+	AvailableKeys []chain.PublicKey `json:"available_keys"`
+}
+
+type getRequiredKeysResp struct {
+	RequiredKeys []chain.PublicKey `json:"required_keys"`
+}
+
+//	GetRequiredKeys - Fetches "/v1/chain/get_required_keys" from API
+//
+// ---------------------------------------------------------
+func (c *Client) GetRequiredKeys(ctx context.Context, tx chain.Transaction, availableKeys []chain.PublicKey) ([]chain.PublicKey, error) {
+	body := getRequiredKeysReq{
+		Transaction:   tx,
+		AvailableKeys: availableKeys,
+	}
+	if body.AvailableKeys == nil {
+		body.AvailableKeys = []chain.PublicKey{}
+	}
+
+	var resp getRequiredKeysResp
+	err := c.send(ctx, "POST", "/v1/chain/get_required_keys", body, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp.RequiredKeys, nil
+}
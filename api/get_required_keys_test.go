@@ -0,0 +1,96 @@
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/shufflingpixels/antelope-go/chain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRequiredKeys(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/v1/chain/get_required_keys", req.URL.String())
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{
+			"transaction": {
+				"expiration": "2009-02-13T23:31:30",
+				"ref_block_num": 11,
+				"ref_block_prefix": 22,
+				"max_net_usage_words": 0,
+				"max_cpu_usage_ms": 0,
+				"delay_sec": 0,
+				"context_free_actions": [],
+				"actions": [
+					{
+						"account": "eosio.token",
+						"name": "transfer",
+						"authorization": [{"actor": "alice", "permission": "active"}],
+						"data": "deadbeef"
+					}
+				],
+				"transaction_extensions": []
+			},
+			"available_keys": [
+				"PUB_K1_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5BoDq63",
+				"PUB_K1_7KtnQUSGVf4vbFE2eQsWmDp4iV93jVcSmdQXtRdRRnWj21Assc"
+			]
+		}`, string(body))
+		_, _ = res.Write([]byte(`{"required_keys": ["EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV"]}`))
+	}))
+	defer srv.Close()
+
+	tx := chain.Transaction{
+		TransactionHeader: chain.TransactionHeader{
+			Expiration:     chain.TimePointSec(1234567890),
+			RefBlockNum:    11,
+			RefBlockPrefix: 22,
+		},
+		ContextFreeActions: []chain.Action{},
+		Actions: []chain.Action{
+			{
+				Account:       chain.N("eosio.token"),
+				Name:          chain.N("transfer"),
+				Authorization: []chain.PermissionLevel{{Actor: chain.N("alice"), Permission: chain.N("active")}},
+				Data:          chain.Bytes{0xde, 0xad, 0xbe, 0xef},
+			},
+		},
+		Extensions: []chain.TransactionExtension{},
+	}
+	available := []chain.PublicKey{
+		chain.MustNewPublicKeyFromString("PUB_K1_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5BoDq63"),
+		chain.MustNewPublicKeyFromString("EOS7KtnQUSGVf4vbFE2eQsWmDp4iV93jVcSmdQXtRdRRnWj2ubbFW"),
+	}
+
+	keys, err := New(srv.URL).GetRequiredKeys(context.Background(), tx, available)
+	require.NoError(t, err)
+	assert.Equal(t, []chain.PublicKey{available[0]}, keys)
+}
+
+func TestComputeTransaction(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/v1/chain/compute_transaction", req.URL.String())
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{
+			"transaction": {
+				"signatures": [],
+				"compression": "none",
+				"packed_context_free_data": "",
+				"packed_trx": "deadbeef"
+			}
+		}`, string(body))
+		_, _ = res.Write([]byte(sendTransactionResp))
+	}))
+	defer srv.Close()
+
+	resp, err := New(srv.URL).ComputeTransaction(context.Background(), packedTestTransaction)
+	require.NoError(t, err)
+	assert.Equal(t, "7f84ff0d833c5965f73fb9651881c5b233ab07b45b1a2646b82946f66b78ff92", resp.TransactionID.String())
+	assert.Equal(t, "hello world", resp.Processed.Console())
+}
@@ -0,0 +1,218 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/shufflingpixels/antelope-go/chain"
+)
+
+type GetTableRowsReq struct {
+	Code  chain.Name
+	Scope string
+	Table chain.Name
+	// Index to query, "primary", "secondary", "tertiary" ... or the index number (1 is primary).
+	IndexPosition string
+	// Key type of the index, "i64", "i128", "i256", "float64", "float128", "sha256", "ripemd160" or "name"
+	KeyType    string
+	LowerBound string
+	UpperBound string
+	Limit      uint32
+	Reverse    bool
+	ShowPayer  bool
+
+	// If set, rows are requested in binary form (json=false)
+	// and decoded locally using the table's type in the ABI.
+	Abi *chain.Abi
+	// Request rows in binary form without decoding them.
+	Binary bool
+}
+
+type getTableRowsReq struct {
+	Code          chain.Name `json:"code"`
+	Scope         string     `json:"scope"`
+	Table         chain.Name `json:"table"`
+	IndexPosition string     `json:"index_position,omitempty"`
+	KeyType       string     `json:"key_type,omitempty"`
+	LowerBound    string     `json:"lower_bound,omitempty"`
+	UpperBound    string     `json:"upper_bound,omitempty"`
+	Limit         uint32     `json:"limit,omitempty"`
+	Reverse       bool       `json:"reverse,omitempty"`
+	ShowPayer     bool       `json:"show_payer,omitempty"`
+	JSON          bool       `json:"json"`
+}
+
+type getTableRowsResp struct {
+	Rows    []jsoniter.RawMessage `json:"rows"`
+	More    bool                  `json:"more"`
+	NextKey string                `json:"next_key"`
+}
+
+type TableRow struct {
+	// The row as returned by the API, a json value or a hex string for binary rows.
+	Data jsoniter.RawMessage
+	// Payer of the row, only set if ShowPayer was requested.
+	Payer chain.Name
+	// Row decoded with the ABI, only set if Abi was given in the request.
+	Decoded interface{}
+}
+
+// TableRows - Struct for "/v1/chain/get_table_rows" API
+type TableRows struct {
+	Rows    []TableRow
+	More    bool
+	NextKey string
+}
+
+// Set lower_bound and key_type from an index key.
+func (r *GetTableRowsReq) SetLowerBound(k chain.IndexKey) {
+	r.KeyType = k.Type
+	r.LowerBound = k.Value
+}
+
+// Set upper_bound and key_type from an index key.
+func (r *GetTableRowsReq) SetUpperBound(k chain.IndexKey) {
+	r.KeyType = k.Type
+	r.UpperBound = k.Value
+}
+
+// Unmarshal the json row into v.
+func (r TableRow) Unmarshal(v interface{}) error {
+	return json.Unmarshal(r.Data, v)
+}
+
+// Returns the raw row of a binary request.
+func (r TableRow) Bytes() (chain.Bytes, error) {
+	var s string
+	if err := json.Unmarshal(r.Data, &s); err != nil {
+		return nil, err
+	}
+	return hex.DecodeString(s)
+}
+
+// Decode the raw row of a binary request into v.
+func (r TableRow) DecodeInto(v interface{}) error {
+	b, err := r.Bytes()
+	if err != nil {
+		return err
+	}
+	return chain.NewDecoder(bytes.NewReader(b)).Decode(v)
+}
+
+//	GetTableRows - Fetches "/v1/chain/get_table_rows" from API
+//
+// ---------------------------------------------------------
+func (c *Client) GetTableRows(ctx context.Context, req GetTableRowsReq) (rows TableRows, err error) {
+	var rowType string
+	if req.Abi != nil {
+		table := req.Abi.GetTable(req.Table.String())
+		if table == nil {
+			return rows, fmt.Errorf("table %s not found in abi", req.Table)
+		}
+		rowType = table.Type
+	}
+
+	body := getTableRowsReq{
+		Code:          req.Code,
+		Scope:         req.Scope,
+		Table:         req.Table,
+		IndexPosition: req.IndexPosition,
+		KeyType:       req.KeyType,
+		LowerBound:    req.LowerBound,
+		UpperBound:    req.UpperBound,
+		Limit:         req.Limit,
+		Reverse:       req.Reverse,
+		ShowPayer:     req.ShowPayer,
+		JSON:          !req.Binary && req.Abi == nil,
+	}
+
+	var resp getTableRowsResp
+	err = c.send(ctx, "POST", "/v1/chain/get_table_rows", body, &resp)
+	if err != nil {
+		return
+	}
+
+	rows.More = resp.More
+	rows.NextKey = resp.NextKey
+	rows.Rows = make([]TableRow, len(resp.Rows))
+	for i, data := range resp.Rows {
+		row := &rows.Rows[i]
+		if req.ShowPayer {
+			var v struct {
+				Data  jsoniter.RawMessage `json:"data"`
+				Payer chain.Name          `json:"payer"`
+			}
+			if err = json.Unmarshal(data, &v); err != nil {
+				return
+			}
+			row.Data, row.Payer = v.Data, v.Payer
+		} else {
+			row.Data = data
+		}
+
+		if req.Abi != nil {
+			var b chain.Bytes
+			if b, err = row.Bytes(); err != nil {
+				return
+			}
+			if row.Decoded, err = req.Abi.Decode(bytes.NewReader(b), rowType); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// TableRowsIterator fetches pages of table rows, following next_key until the table is exhausted.
+type TableRowsIterator struct {
+	client *Client
+	req    GetTableRowsReq
+	rows   TableRows
+	done   bool
+	err    error
+}
+
+func (c *Client) NewTableRowsIterator(req GetTableRowsReq) *TableRowsIterator {
+	return &TableRowsIterator{
+		client: c,
+		req:    req,
+	}
+}
+
+// Fetch the next page, returns false when there are no more rows or an error occurred.
+func (it *TableRowsIterator) Next(ctx context.Context) bool {
+	if it.done {
+		return false
+	}
+
+	it.rows, it.err = it.client.GetTableRows(ctx, it.req)
+	if it.err != nil {
+		it.done = true
+		return false
+	}
+
+	if !it.rows.More {
+		it.done = true
+	} else if len(it.rows.NextKey) < 1 {
+		it.done = true
+		it.err = errors.New("more rows available but api did not return next_key")
+	} else if it.req.Reverse {
+		it.req.UpperBound = it.rows.NextKey
+	} else {
+		it.req.LowerBound = it.rows.NextKey
+	}
+	return len(it.rows.Rows) > 0 || !it.done
+}
+
+// Rows of the current page.
+func (it *TableRowsIterator) Rows() []TableRow {
+	return it.rows.Rows
+}
+
+func (it *TableRowsIterator) Err() error {
+	return it.err
+}
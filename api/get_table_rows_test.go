@@ -0,0 +1,227 @@
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/shufflingpixels/antelope-go/chain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var accountsAbi = chain.Abi{
+	Version: "eosio::abi/1.1",
+	Structs: []chain.AbiStruct{
+		{Name: "account", Fields: []chain.AbiField{{Name: "balance", Type: "asset"}}},
+	},
+	Tables: []chain.AbiTable{
+		{Name: "accounts", IndexType: "i64", KeyNames: []string{}, KeyTypes: []string{}, Type: "account"},
+	},
+}
+
+type accountRow struct {
+	Balance chain.Asset `json:"balance"`
+}
+
+func TestGetTableRows(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/v1/chain/get_table_rows", req.URL.String())
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{
+			"code": "eosio.token",
+			"scope": "alice",
+			"table": "accounts",
+			"index_position": "secondary",
+			"key_type": "name",
+			"lower_bound": "a",
+			"upper_bound": "z",
+			"limit": 10,
+			"reverse": true,
+			"show_payer": true,
+			"json": true
+		}`, string(body))
+
+		payload := `{
+			"rows": [
+				{"data": {"balance": "1.0000 EOS"}, "payer": "alice"},
+				{"data": {"balance": "2.5000 EOS"}, "payer": "bob"}
+			],
+			"more": false,
+			"next_key": ""
+		}`
+		_, _ = res.Write([]byte(payload))
+	}))
+	defer srv.Close()
+
+	client := New(srv.URL)
+
+	rows, err := client.GetTableRows(context.Background(), GetTableRowsReq{
+		Code:          chain.N("eosio.token"),
+		Scope:         "alice",
+		Table:         chain.N("accounts"),
+		IndexPosition: "secondary",
+		KeyType:       "name",
+		LowerBound:    "a",
+		UpperBound:    "z",
+		Limit:         10,
+		Reverse:       true,
+		ShowPayer:     true,
+	})
+	require.NoError(t, err)
+	assert.False(t, rows.More)
+	require.Len(t, rows.Rows, 2)
+	assert.Equal(t, chain.N("alice"), rows.Rows[0].Payer)
+	assert.Equal(t, chain.N("bob"), rows.Rows[1].Payer)
+
+	var row accountRow
+	require.NoError(t, rows.Rows[1].Unmarshal(&row))
+	assert.Equal(t, *chain.A("2.5000 EOS"), row.Balance)
+}
+
+func TestGetTableRowsBinary(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{
+			"code": "eosio.token",
+			"scope": "alice",
+			"table": "accounts",
+			"json": false
+		}`, string(body))
+
+		payload := `{
+			"rows": ["102700000000000004454f5300000000"],
+			"more": false,
+			"next_key": ""
+		}`
+		_, _ = res.Write([]byte(payload))
+	}))
+	defer srv.Close()
+
+	client := New(srv.URL)
+	req := GetTableRowsReq{
+		Code:  chain.N("eosio.token"),
+		Scope: "alice",
+		Table: chain.N("accounts"),
+	}
+
+	// decode with abi
+	req.Abi = &accountsAbi
+	rows, err := client.GetTableRows(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, rows.Rows, 1)
+	assert.Equal(t, map[string]interface{}{"balance": *chain.A("1.0000 EOS")}, rows.Rows[0].Decoded)
+
+	// decode into struct
+	req.Abi = nil
+	req.Binary = true
+	rows, err = client.GetTableRows(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, rows.Rows, 1)
+	assert.Nil(t, rows.Rows[0].Decoded)
+
+	var row accountRow
+	require.NoError(t, rows.Rows[0].DecodeInto(&row))
+	assert.Equal(t, *chain.A("1.0000 EOS"), row.Balance)
+}
+
+func TestGetTableRowsUnknownTable(t *testing.T) {
+	client := New("http://localhost")
+
+	_, err := client.GetTableRows(context.Background(), GetTableRowsReq{
+		Code:  chain.N("eosio.token"),
+		Scope: "alice",
+		Table: chain.N("stat"),
+		Abi:   &accountsAbi,
+	})
+	assert.EqualError(t, err, "table stat not found in abi")
+}
+
+func TestTableRowsIterator(t *testing.T) {
+	pages := map[string]string{
+		"":  `{"rows": [{"balance": "1.0000 EOS"}, {"balance": "2.0000 EOS"}], "more": true, "next_key": "3"}`,
+		"3": `{"rows": [{"balance": "3.0000 EOS"}, {"balance": "4.0000 EOS"}], "more": true, "next_key": "5"}`,
+		"5": `{"rows": [{"balance": "5.0000 EOS"}], "more": false, "next_key": ""}`,
+	}
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		requests++
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		lower, _ := body["lower_bound"].(string)
+		_, _ = res.Write([]byte(pages[lower]))
+	}))
+	defer srv.Close()
+
+	client := New(srv.URL)
+	it := client.NewTableRowsIterator(GetTableRowsReq{
+		Code:  chain.N("eosio.token"),
+		Scope: "eosio.token",
+		Table: chain.N("accounts"),
+		Limit: 2,
+	})
+
+	balances := []string{}
+	for it.Next(context.Background()) {
+		for _, r := range it.Rows() {
+			var row accountRow
+			require.NoError(t, r.Unmarshal(&row))
+			balances = append(balances, row.Balance.String())
+		}
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, 3, requests)
+	assert.Equal(t, []string{"1.0000 EOS", "2.0000 EOS", "3.0000 EOS", "4.0000 EOS", "5.0000 EOS"}, balances)
+	assert.False(t, it.Next(context.Background()))
+}
+
+func TestTableRowsIteratorError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(500)
+	}))
+	defer srv.Close()
+
+	it := New(srv.URL).NewTableRowsIterator(GetTableRowsReq{Code: chain.N("eosio.token")})
+	assert.False(t, it.Next(context.Background()))
+	assert.Equal(t, HTTPError{Code: 500}, it.Err())
+}
+
+func TestGetTableRowsIndexKey(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{
+			"code": "eosio.token",
+			"scope": "eosio.token",
+			"table": "stat",
+			"index_position": "2",
+			"key_type": "sha256",
+			"lower_bound": "f58262c8005bb64b8f99ec6083faf050c502d099d9929ae37ffed2fe1bb954fb",
+			"upper_bound": "f58262c8005bb64b8f99ec6083faf050c502d099d9929ae37ffed2fe1bb954fc",
+			"json": true
+		}`, string(body))
+		_, _ = res.Write([]byte(`{"rows": [], "more": false, "next_key": ""}`))
+	}))
+	defer srv.Close()
+
+	var lower, upper chain.Checksum256
+	require.NoError(t, lower.UnmarshalText([]byte("f58262c8005bb64b8f99ec6083faf050c502d099d9929ae37ffed2fe1bb954fb")))
+	require.NoError(t, upper.UnmarshalText([]byte("f58262c8005bb64b8f99ec6083faf050c502d099d9929ae37ffed2fe1bb954fc")))
+
+	req := GetTableRowsReq{
+		Code:          chain.N("eosio.token"),
+		Scope:         "eosio.token",
+		Table:         chain.N("stat"),
+		IndexPosition: "2",
+	}
+	req.SetLowerBound(chain.NewChecksum256IndexKey(lower))
+	req.SetUpperBound(chain.NewChecksum256IndexKey(upper))
+
+	rows, err := New(srv.URL).GetTableRows(context.Background(), req)
+	require.NoError(t, err)
+	assert.Empty(t, rows.Rows)
+}
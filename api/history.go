@@ -0,0 +1,188 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/shufflingpixels/antelope-go/chain"
+)
+
+// Endpoints of the (v1) history plugin.
+
+// HistoryAction - Action in "/v1/history/get_actions" API
+type HistoryAction struct {
+	GlobalActionSeq  chain.Uint64   `json:"global_action_seq"`
+	AccountActionSeq int64          `json:"account_action_seq"`
+	BlockNum         chain.BlockNum `json:"block_num"`
+	BlockTime        time.Time      `json:"block_time" time_format:"antelope-api" time_location:"antelope-api"`
+	ActionTrace      ActionTrace    `json:"action_trace"`
+}
+
+// ActionsResp - Struct for "/v1/history/get_actions" API
+type ActionsResp struct {
+	Actions               []HistoryAction `json:"actions"`
+	LastIrreversibleBlock chain.BlockNum  `json:"last_irreversible_block"`
+	TimeLimitExceededErr  bool            `json:"time_limit_exceeded_error,omitempty"`
+}
+
+// HistoryTrx is the transaction with ABI decoded actions.
+type HistoryTrx struct {
+	chain.TransactionHeader
+	ContextFreeActions []TraceAction                `json:"context_free_actions"`
+	Actions            []TraceAction                `json:"actions"`
+	Extensions         []chain.TransactionExtension `json:"transaction_extensions"`
+	Signatures         []chain.Signature            `json:"signatures"`
+	ContextFreeData    []chain.Bytes                `json:"context_free_data"`
+}
+
+type HistoryTransactionTrx struct {
+	Receipt TransactionReceipt `json:"receipt"`
+	Trx     HistoryTrx         `json:"trx"`
+}
+
+// HistoryTransaction - Struct for "/v1/history/get_transaction" API
+type HistoryTransaction struct {
+	ID                    chain.Checksum256     `json:"id"`
+	Trx                   HistoryTransactionTrx `json:"trx"`
+	BlockTime             time.Time             `json:"block_time" time_format:"antelope-api" time_location:"antelope-api"`
+	BlockNum              chain.BlockNum        `json:"block_num"`
+	LastIrreversibleBlock chain.BlockNum        `json:"last_irreversible_block"`
+	Traces                []ActionTrace         `json:"traces"`
+}
+
+// Returns the raw action, use chain.Action.Decode to decode the data.
+func (a HistoryAction) Action() (*chain.Action, error) {
+	return a.ActionTrace.Act.Action()
+}
+
+// Returns true if the transaction is in an irreversible block.
+func (t HistoryTransaction) Irreversible() bool {
+	return t.BlockNum <= t.LastIrreversibleBlock
+}
+
+//	GetActions - Fetches "/v1/history/get_actions" from API
+//
+// pos is the account action sequence to start at (-1 for the latest action)
+// and offset the number of actions relative to pos, negative offsets
+// fetch actions before pos.
+//
+// ---------------------------------------------------------
+func (c *Client) GetActions(ctx context.Context, account chain.Name, pos int64, offset int64) (resp ActionsResp, err error) {
+	body := map[string]interface{}{
+		"account_name": account,
+		"pos":          pos,
+		"offset":       offset,
+	}
+
+	err = c.send(ctx, "POST", "/v1/history/get_actions", body, &resp)
+	return
+}
+
+//	GetTransaction - Fetches "/v1/history/get_transaction" from API
+//
+// ---------------------------------------------------------
+func (c *Client) GetTransaction(ctx context.Context, id chain.Checksum256) (tx HistoryTransaction, err error) {
+	body := map[string]interface{}{
+		"id": id,
+	}
+
+	err = c.send(ctx, "POST", "/v1/history/get_transaction", body, &tx)
+	return
+}
+
+//	GetKeyAccounts - Fetches "/v1/history/get_key_accounts" from API
+//
+// ---------------------------------------------------------
+func (c *Client) GetKeyAccounts(ctx context.Context, key chain.PublicKey) (accounts []chain.Name, err error) {
+	body := map[string]interface{}{
+		"public_key": key,
+	}
+
+	var resp struct {
+		AccountNames []chain.Name `json:"account_names"`
+	}
+	err = c.send(ctx, "POST", "/v1/history/get_key_accounts", body, &resp)
+	accounts = resp.AccountNames
+	return
+}
+
+//	GetControlledAccounts - Fetches "/v1/history/get_controlled_accounts" from API
+//
+// ---------------------------------------------------------
+func (c *Client) GetControlledAccounts(ctx context.Context, account chain.Name) (accounts []chain.Name, err error) {
+	body := map[string]interface{}{
+		"controlling_account": account,
+	}
+
+	var resp struct {
+		ControlledAccounts []chain.Name `json:"controlled_accounts"`
+	}
+	err = c.send(ctx, "POST", "/v1/history/get_controlled_accounts", body, &resp)
+	accounts = resp.ControlledAccounts
+	return
+}
+
+var ErrActionsTimeLimit = errors.New("get_actions time limit exceeded without returning any actions")
+
+// ActionsIterator walks the full action history of an account, oldest action first.
+type ActionsIterator struct {
+	client   *Client
+	account  chain.Name
+	pos      int64
+	pageSize int64
+	actions  []HistoryAction
+	done     bool
+	err      error
+}
+
+func (c *Client) NewActionsIterator(account chain.Name, pageSize uint32) *ActionsIterator {
+	if pageSize < 1 {
+		pageSize = 100
+	}
+	return &ActionsIterator{
+		client:   c,
+		account:  account,
+		pageSize: int64(pageSize),
+	}
+}
+
+// Fetch the next page, returns false when there are no more actions or an error occurred.
+func (it *ActionsIterator) Next(ctx context.Context) bool {
+	if it.done {
+		return false
+	}
+
+	resp, err := it.client.GetActions(ctx, it.account, it.pos, it.pageSize-1)
+	if err != nil {
+		it.err = err
+		it.done = true
+		return false
+	}
+
+	it.actions = resp.Actions
+	if len(it.actions) < 1 {
+		// nodeos ran out of time before it found any action, we can't tell if there are more.
+		if resp.TimeLimitExceededErr {
+			it.err = ErrActionsTimeLimit
+		}
+		it.done = true
+		return false
+	}
+
+	it.pos = it.actions[len(it.actions)-1].AccountActionSeq + 1
+	// A page truncated by the time limit is short, but not the end of the history.
+	if int64(len(it.actions)) < it.pageSize && !resp.TimeLimitExceededErr {
+		it.done = true
+	}
+	return true
+}
+
+// Actions of the current page.
+func (it *ActionsIterator) Actions() []HistoryAction {
+	return it.actions
+}
+
+func (it *ActionsIterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,311 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/shufflingpixels/antelope-go/chain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var tokenAbi = chain.Abi{
+	Version: "eosio::abi/1.1",
+	Structs: []chain.AbiStruct{
+		{Name: "transfer", Fields: []chain.AbiField{
+			{Name: "from", Type: "name"},
+			{Name: "to", Type: "name"},
+			{Name: "quantity", Type: "asset"},
+			{Name: "memo", Type: "string"},
+		}},
+	},
+	Actions: []chain.AbiAction{
+		{Name: "transfer", Type: "transfer"},
+	},
+}
+
+// alice -> bob 1.0000 EOS "hi"
+const transferHexData = "0000000000855c340000000000000e3d102700000000000004454f5300000000026869"
+
+func historyAction(seq int64) string {
+	return fmt.Sprintf(`{
+		"global_action_seq": %d,
+		"account_action_seq": %d,
+		"block_num": %d,
+		"block_time": "2024-03-01T10:00:00.500",
+		"action_trace": {
+			"action_ordinal": 1,
+			"creator_action_ordinal": 0,
+			"closest_unnotified_ancestor_action_ordinal": 0,
+			"receipt": {
+				"receiver": "alice",
+				"act_digest": "a5c1f3b4c0d1d1c8e8a1f0ba1fd07ba4c1c8f2b3c0e0d2f5c9bb3a58cd0f40a9",
+				"global_sequence": %d,
+				"recv_sequence": 1,
+				"auth_sequence": [["alice", 1]],
+				"code_sequence": 1,
+				"abi_sequence": 1
+			},
+			"receiver": "alice",
+			"act": {
+				"account": "eosio.token",
+				"name": "transfer",
+				"authorization": [{"actor": "alice", "permission": "active"}],
+				"data": {"from": "alice", "to": "bob", "quantity": "1.0000 EOS", "memo": "hi"},
+				"hex_data": "%s"
+			},
+			"context_free": false,
+			"elapsed": 10,
+			"console": "",
+			"trx_id": "3e8ee9e3a5bd5ea0e0b1d4bd6c84faaf5d5fcf7cd42cc0c8d7e3c06f4ad2de60",
+			"block_num": %d,
+			"block_time": "2024-03-01T10:00:00.500",
+			"producer_block_id": null,
+			"account_ram_deltas": [],
+			"except": null,
+			"error_code": null
+		}
+	}`, 1000+seq, seq, 100+seq, 1000+seq, transferHexData, 100+seq)
+}
+
+func TestGetActions(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/v1/history/get_actions", req.URL.String())
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"account_name": "alice", "pos": -1, "offset": -1}`, string(body))
+		_, _ = res.Write([]byte(`{"actions": [` + historyAction(7) + `], "last_irreversible_block": 200}`))
+	}))
+	defer srv.Close()
+
+	resp, err := New(srv.URL).GetActions(context.Background(), chain.N("alice"), -1, -1)
+	require.NoError(t, err)
+	assert.Equal(t, chain.BlockNum(200), resp.LastIrreversibleBlock)
+	require.Len(t, resp.Actions, 1)
+
+	a := resp.Actions[0]
+	assert.Equal(t, chain.Uint64(1007), a.GlobalActionSeq)
+	assert.Equal(t, int64(7), a.AccountActionSeq)
+	assert.Equal(t, chain.BlockNum(107), a.BlockNum)
+	assert.Equal(t, time.Date(2024, 3, 1, 10, 0, 0, 500000000, time.UTC), a.BlockTime)
+	assert.Equal(t, chain.N("alice"), a.ActionTrace.Receiver)
+
+	act, err := a.Action()
+	require.NoError(t, err)
+	assert.Equal(t, chain.N("eosio.token"), act.Account)
+	assert.Equal(t, chain.N("transfer"), act.Name)
+
+	data, err := act.Decode(&tokenAbi)
+	require.NoError(t, err)
+	assert.Equal(t, *chain.A("1.0000 EOS"), data["quantity"])
+	assert.Equal(t, "hi", data["memo"])
+}
+
+func TestActionsIterator(t *testing.T) {
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		requests = append(requests, string(body))
+
+		var params struct {
+			Pos    int64 `json:"pos"`
+			Offset int64 `json:"offset"`
+		}
+		assert.NoError(t, json.Unmarshal(body, &params))
+
+		// account has 3 actions (0, 1, 2)
+		actions := []string{}
+		for seq := params.Pos; seq <= params.Pos+params.Offset && seq < 3; seq++ {
+			actions = append(actions, historyAction(seq))
+		}
+		out := `{"actions": [`
+		for i, a := range actions {
+			if i > 0 {
+				out += ","
+			}
+			out += a
+		}
+		_, _ = res.Write([]byte(out + `], "last_irreversible_block": 200}`))
+	}))
+	defer srv.Close()
+
+	it := New(srv.URL).NewActionsIterator(chain.N("alice"), 2)
+	seqs := []int64{}
+	for it.Next(context.Background()) {
+		for _, a := range it.Actions() {
+			seqs = append(seqs, a.AccountActionSeq)
+		}
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, []int64{0, 1, 2}, seqs)
+	require.Len(t, requests, 2)
+	assert.JSONEq(t, `{"account_name": "alice", "pos": 0, "offset": 1}`, requests[0])
+	assert.JSONEq(t, `{"account_name": "alice", "pos": 2, "offset": 1}`, requests[1])
+}
+
+func TestActionsIteratorTimeLimitExceeded(t *testing.T) {
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		requests = append(requests, string(body))
+
+		var params struct {
+			Pos int64 `json:"pos"`
+		}
+		assert.NoError(t, json.Unmarshal(body, &params))
+
+		// account has 5 actions, the first page is cut short by the time limit.
+		switch params.Pos {
+		case 0:
+			_, _ = res.Write([]byte(`{"actions": [` + historyAction(0) + `], "last_irreversible_block": 200, "time_limit_exceeded_error": true}`))
+		case 1:
+			_, _ = res.Write([]byte(`{"actions": [` + historyAction(1) + `,` + historyAction(2) + `,` + historyAction(3) + `], "last_irreversible_block": 200}`))
+		default:
+			_, _ = res.Write([]byte(`{"actions": [` + historyAction(4) + `], "last_irreversible_block": 200}`))
+		}
+	}))
+	defer srv.Close()
+
+	it := New(srv.URL).NewActionsIterator(chain.N("alice"), 3)
+	seqs := []int64{}
+	for it.Next(context.Background()) {
+		for _, a := range it.Actions() {
+			seqs = append(seqs, a.AccountActionSeq)
+		}
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, []int64{0, 1, 2, 3, 4}, seqs)
+	require.Len(t, requests, 3)
+	assert.JSONEq(t, `{"account_name": "alice", "pos": 1, "offset": 2}`, requests[1])
+	assert.JSONEq(t, `{"account_name": "alice", "pos": 4, "offset": 2}`, requests[2])
+}
+
+func TestActionsIteratorTimeLimitExceededEmpty(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		_, _ = res.Write([]byte(`{"actions": [], "last_irreversible_block": 200, "time_limit_exceeded_error": true}`))
+	}))
+	defer srv.Close()
+
+	it := New(srv.URL).NewActionsIterator(chain.N("alice"), 3)
+	assert.False(t, it.Next(context.Background()))
+	assert.ErrorIs(t, it.Err(), ErrActionsTimeLimit)
+}
+
+func TestActionsIteratorError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	it := New(srv.URL).NewActionsIterator(chain.N("alice"), 10)
+	assert.False(t, it.Next(context.Background()))
+	assert.Equal(t, HTTPError{Code: 500}, it.Err())
+	assert.False(t, it.Next(context.Background()))
+}
+
+func TestGetTransaction(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/v1/history/get_transaction", req.URL.String())
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"id": "3e8ee9e3a5bd5ea0e0b1d4bd6c84faaf5d5fcf7cd42cc0c8d7e3c06f4ad2de60"}`, string(body))
+		_, _ = res.Write([]byte(`{
+			"id": "3e8ee9e3a5bd5ea0e0b1d4bd6c84faaf5d5fcf7cd42cc0c8d7e3c06f4ad2de60",
+			"trx": {
+				"receipt": {
+					"status": "executed",
+					"cpu_usage_us": 150,
+					"net_usage_words": 16,
+					"trx": [1, {
+						"signatures": [],
+						"compression": "none",
+						"packed_context_free_data": "",
+						"context_free_data": [],
+						"packed_trx": "d20296490b0016000000212c370001000000000000285d000000000000ae39000000"
+					}]
+				},
+				"trx": {
+					"expiration": "2009-02-13T23:31:30",
+					"ref_block_num": 11,
+					"ref_block_prefix": 3704406,
+					"max_net_usage_words": 0,
+					"max_cpu_usage_ms": 0,
+					"delay_sec": 0,
+					"context_free_actions": [],
+					"actions": [{
+						"account": "eosio.token",
+						"name": "transfer",
+						"authorization": [{"actor": "alice", "permission": "active"}],
+						"data": {"from": "alice", "to": "bob", "quantity": "1.0000 EOS", "memo": "hi"},
+						"hex_data": "` + transferHexData + `"
+					}],
+					"transaction_extensions": [],
+					"signatures": [],
+					"context_free_data": []
+				}
+			},
+			"block_time": "2024-03-01T10:00:00.500",
+			"block_num": 107,
+			"last_irreversible_block": 200,
+			"traces": [` + historyAction(7) + `]
+		}`))
+	}))
+	defer srv.Close()
+
+	var id chain.Checksum256
+	require.NoError(t, id.UnmarshalText([]byte("3e8ee9e3a5bd5ea0e0b1d4bd6c84faaf5d5fcf7cd42cc0c8d7e3c06f4ad2de60")))
+	tx, err := New(srv.URL).GetTransaction(context.Background(), id)
+	require.NoError(t, err)
+	assert.Equal(t, id, tx.ID)
+	assert.Equal(t, chain.BlockNum(107), tx.BlockNum)
+	assert.True(t, tx.Irreversible())
+	assert.Equal(t, chain.TransactionStatusExecuted, tx.Trx.Receipt.Status)
+	require.NotNil(t, tx.Trx.Receipt.Trx.Packed)
+	assert.Equal(t, uint16(11), tx.Trx.Trx.RefBlockNum)
+	require.Len(t, tx.Trx.Trx.Actions, 1)
+
+	act, err := tx.Trx.Trx.Actions[0].Action()
+	require.NoError(t, err)
+	data, err := act.Decode(&tokenAbi)
+	require.NoError(t, err)
+	assert.Equal(t, "hi", data["memo"])
+	require.Len(t, tx.Traces, 1)
+}
+
+func TestGetKeyAccounts(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/v1/history/get_key_accounts", req.URL.String())
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"public_key": "PUB_K1_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5BoDq63"}`, string(body))
+		_, _ = res.Write([]byte(`{"account_names": ["alice", "bob"]}`))
+	}))
+	defer srv.Close()
+
+	key := chain.MustNewPublicKeyFromString("PUB_K1_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5BoDq63")
+	accounts, err := New(srv.URL).GetKeyAccounts(context.Background(), key)
+	require.NoError(t, err)
+	assert.Equal(t, []chain.Name{chain.N("alice"), chain.N("bob")}, accounts)
+}
+
+func TestGetControlledAccounts(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/v1/history/get_controlled_accounts", req.URL.String())
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"controlling_account": "alice"}`, string(body))
+		_, _ = res.Write([]byte(`{"controlled_accounts": ["alice.msig"]}`))
+	}))
+	defer srv.Close()
+
+	accounts, err := New(srv.URL).GetControlledAccounts(context.Background(), chain.N("alice"))
+	require.NoError(t, err)
+	assert.Equal(t, []chain.Name{chain.N("alice.msig")}, accounts)
+}
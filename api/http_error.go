@@ -4,11 +4,14 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 )
 
 type HTTPError struct {
 	Code    int
 	Message string
+	// Value of the Retry-After header, zero if not present.
+	RetryAfter time.Duration
 }
 
 func (e HTTPError) Error() string {
@@ -0,0 +1,61 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// Request as seen by interceptors, they are free to modify it before
+// passing it on (for example to add authentication headers).
+type Request struct {
+	Method string
+	// Full url, including Path.
+	Url  string
+	Path string
+	// Headers to send, the "Host" header is used as the request host.
+	Header http.Header
+	// JSON encoded body, nil if there is none.
+	Body []byte
+}
+
+// Response as seen by interceptors.
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+	// Time spent waiting for the server.
+	Duration time.Duration
+}
+
+// RoundTrip sends a request and returns the response.
+type RoundTrip func(ctx context.Context, req *Request) (*Response, error)
+
+// Interceptor wraps every http request made by the client, it must call next
+// to continue the chain (or return a response/error on its own).
+//
+// Interceptors run for each attempt, so retries are seen as separate requests.
+type Interceptor func(ctx context.Context, req *Request, next RoundTrip) (*Response, error)
+
+// Add interceptors to the client, they are called in the order they are added.
+// On a pool client, the interceptors are added to all endpoints.
+func (c *Client) Use(interceptors ...Interceptor) *Client {
+	if c.pool != nil {
+		c.pool.Use(interceptors...)
+		return c
+	}
+	c.interceptors = append(c.interceptors, interceptors...)
+	return c
+}
+
+func chainInterceptors(interceptors []Interceptor, last RoundTrip) RoundTrip {
+	next := last
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		next = func(ic Interceptor, next RoundTrip) RoundTrip {
+			return func(ctx context.Context, req *Request) (*Response, error) {
+				return ic(ctx, req, next)
+			}
+		}(interceptors[i], next)
+	}
+	return next
+}
@@ -0,0 +1,170 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInterceptors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "Bearer secret", req.Header.Get("Authorization"))
+		assert.Equal(t, "application/json; charset=utf-8", req.Header.Get("Content-Type"))
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"block_num_or_id": "1"}`, string(body))
+		_, _ = res.Write([]byte(`{"block_num": 1}`))
+	}))
+	defer srv.Close()
+
+	var calls []string
+	auth := func(ctx context.Context, req *Request, next RoundTrip) (*Response, error) {
+		calls = append(calls, "auth")
+		req.Header.Set("Authorization", "Bearer secret")
+		return next(ctx, req)
+	}
+
+	var seen struct {
+		method string
+		path   string
+		body   string
+		status int
+	}
+	logger := func(ctx context.Context, req *Request, next RoundTrip) (*Response, error) {
+		calls = append(calls, "logger")
+		resp, err := next(ctx, req)
+		require.NoError(t, err)
+		seen.method = req.Method
+		seen.path = req.Path
+		seen.body = string(req.Body)
+		seen.status = resp.StatusCode
+		assert.True(t, resp.Duration > 0)
+		return resp, err
+	}
+
+	block, err := New(srv.URL).Use(auth, logger).GetBlock(context.Background(), "1")
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), uint32(block.BlockNum))
+	assert.Equal(t, []string{"auth", "logger"}, calls)
+	assert.Equal(t, "POST", seen.method)
+	assert.Equal(t, "/v1/chain/get_block", seen.path)
+	assert.JSONEq(t, `{"block_num_or_id": "1"}`, seen.body)
+	assert.Equal(t, http.StatusOK, seen.status)
+}
+
+func TestInterceptorShortCircuit(t *testing.T) {
+	var hits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&hits, 1)
+	}))
+	defer srv.Close()
+
+	client := New(srv.URL).Use(func(ctx context.Context, req *Request, next RoundTrip) (*Response, error) {
+		return &Response{StatusCode: http.StatusOK, Body: []byte(`{"head_block_num": 42}`)}, nil
+	})
+	info, err := client.GetInfo(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), info.HeadBlockNum)
+
+	expected := errors.New("blocked")
+	client = New(srv.URL).Use(func(ctx context.Context, req *Request, next RoundTrip) (*Response, error) {
+		return nil, expected
+	})
+	_, err = client.GetInfo(context.Background())
+	assert.Equal(t, expected, err)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&hits))
+}
+
+func TestInterceptorSeesRetries(t *testing.T) {
+	var hits int32
+	srv := newFlakyServer(1, http.StatusBadGateway, &hits)
+	defer srv.Close()
+
+	var statuses []int
+	client := New(srv.URL).SetRetryPolicy(fastRetryPolicy).Use(func(ctx context.Context, req *Request, next RoundTrip) (*Response, error) {
+		resp, err := next(ctx, req)
+		if err == nil {
+			statuses = append(statuses, resp.StatusCode)
+		}
+		return resp, err
+	})
+	_, err := client.GetInfo(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []int{http.StatusBadGateway, http.StatusOK}, statuses)
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestSetHTTPClient(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		_, _ = res.Write([]byte(`{"head_block_num": 1}`))
+	}))
+	defer srv.Close()
+
+	var used int32
+	hc := &http.Client{
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			atomic.AddInt32(&used, 1)
+			return http.DefaultTransport.RoundTrip(r)
+		}),
+	}
+
+	info, err := New(srv.URL).SetHTTPClient(hc).GetInfo(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), info.HeadBlockNum)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&used))
+}
+
+func TestDefaultHTTPClientTimeout(t *testing.T) {
+	c := New("http://localhost")
+	assert.Equal(t, DefaultTimeout, c.client.Timeout)
+
+	c.SetHTTPClient(&http.Client{})
+	c.SetHTTPClient(nil)
+	assert.Equal(t, DefaultTimeout, c.client.Timeout)
+}
+
+func TestPoolInterceptors(t *testing.T) {
+	bad := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(http.StatusBadGateway)
+	}))
+	defer bad.Close()
+
+	head := int64(100)
+	good := newInfoServer("aca376f2", &head, nil)
+	defer good.Close()
+
+	var urls []string
+	logger := func(ctx context.Context, req *Request, next RoundTrip) (*Response, error) {
+		urls = append(urls, req.Url)
+		return next(ctx, req)
+	}
+
+	var used int32
+	hc := &http.Client{
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			atomic.AddInt32(&used, 1)
+			return http.DefaultTransport.RoundTrip(r)
+		}),
+	}
+
+	client := NewPool(bad.URL, good.URL).Client().Use(logger).SetHTTPClient(hc)
+	info, err := client.GetInfo(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), info.HeadBlockNum)
+
+	// The interceptor sees the request to each endpoint tried.
+	assert.Equal(t, []string{bad.URL + "/v1/chain/get_info", good.URL + "/v1/chain/get_info"}, urls)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&used))
+}
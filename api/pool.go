@@ -0,0 +1,337 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Defaults used by NewPool.
+const (
+	DefaultPoolMaxHeadLag    = 20
+	DefaultPoolPollInterval  = 5 * time.Second
+	DefaultPoolEjectDuration = 30 * time.Second
+)
+
+var ErrNoEndpoints = errors.New("pool has no endpoints")
+
+// Pool holds several nodeos endpoints and routes requests to the healthiest one.
+//
+// Endpoints are polled with "/v1/chain/get_info" to track how far their head
+// block lags behind the best endpoint and that they all report the same chain id.
+// Endpoints that fail to respond, lag behind or are on another chain are ejected
+// and re-admitted when a later poll succeeds (or EjectDuration has passed).
+type Pool struct {
+	// Expected chain id, if empty the chain id reported by most endpoints is used.
+	ChainID string
+
+	// Max number of blocks an endpoint can lag behind the best endpoint and still be healthy.
+	MaxHeadLag int64
+
+	// How often Run polls the endpoints.
+	PollInterval time.Duration
+
+	// How long an endpoint is ejected after a failed request before it is tried again.
+	EjectDuration time.Duration
+
+	mu        sync.Mutex
+	endpoints []*poolEndpoint
+}
+
+type poolEndpoint struct {
+	client     *Client
+	healthy    bool
+	head       int64
+	chainID    string
+	failures   int
+	ejectedAt  time.Time
+	lastErr    error
+	lastPolled time.Time
+}
+
+// EndpointStatus is a snapshot of the health of an endpoint in the pool.
+type EndpointStatus struct {
+	Url          string
+	Healthy      bool
+	HeadBlockNum int64
+	// Number of blocks behind the best endpoint.
+	HeadLag    int64
+	ChainID    string
+	Failures   int
+	LastError  error
+	LastPolled time.Time
+}
+
+// Create a new pool, endpoints are considered healthy until proven otherwise.
+func NewPool(urls ...string) *Pool {
+	p := &Pool{
+		MaxHeadLag:    DefaultPoolMaxHeadLag,
+		PollInterval:  DefaultPoolPollInterval,
+		EjectDuration: DefaultPoolEjectDuration,
+	}
+	for _, u := range urls {
+		p.endpoints = append(p.endpoints, &poolEndpoint{
+			client:  New(u),
+			healthy: true,
+		})
+	}
+	return p
+}
+
+// Returns a client that sends all requests through the pool.
+func (p *Pool) Client() *Client {
+	return &Client{pool: p}
+}
+
+// Poll all endpoints once and update their health.
+func (p *Pool) Poll(ctx context.Context) {
+	type result struct {
+		info Info
+		err  error
+	}
+
+	p.mu.Lock()
+	endpoints := make([]*poolEndpoint, len(p.endpoints))
+	copy(endpoints, p.endpoints)
+	p.mu.Unlock()
+
+	results := make([]result, len(endpoints))
+	wg := sync.WaitGroup{}
+	for i, ep := range endpoints {
+		wg.Add(1)
+		go func(i int, c *Client) {
+			defer wg.Done()
+			results[i].info, results[i].err = c.GetInfo(ctx)
+		}(i, ep.client)
+	}
+	wg.Wait()
+
+	// Don't punish the endpoints for our own cancellation.
+	if ctx.Err() != nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// Lock in the chain id reported by most endpoints.
+	if len(p.ChainID) < 1 {
+		votes := map[string]int{}
+		for _, r := range results {
+			if r.err == nil && len(r.info.ChainID) > 0 {
+				votes[r.info.ChainID]++
+			}
+		}
+		for id, n := range votes {
+			if n > votes[p.ChainID] {
+				p.ChainID = id
+			}
+		}
+	}
+
+	var best int64
+	for _, r := range results {
+		if r.err == nil && r.info.ChainID == p.ChainID && r.info.HeadBlockNum > best {
+			best = r.info.HeadBlockNum
+		}
+	}
+
+	now := time.Now()
+	for i, ep := range endpoints {
+		r := results[i]
+		ep.lastPolled = now
+		if r.err != nil {
+			ep.eject(r.err, now)
+			continue
+		}
+		ep.head = r.info.HeadBlockNum
+		ep.chainID = r.info.ChainID
+		switch {
+		case ep.chainID != p.ChainID:
+			ep.eject(errors.New("chain id mismatch: "+ep.chainID), now)
+		case best-ep.head > p.MaxHeadLag:
+			ep.eject(errors.New("head block lags behind"), now)
+		default:
+			ep.healthy = true
+			ep.failures = 0
+			ep.lastErr = nil
+		}
+	}
+}
+
+// Poll the endpoints every PollInterval until ctx is canceled.
+func (p *Pool) Run(ctx context.Context) {
+	p.Poll(ctx)
+	ticker := time.NewTicker(p.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.Poll(ctx)
+		}
+	}
+}
+
+// Status of all endpoints, in the order they were added.
+func (p *Pool) Status() []EndpointStatus {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	best := p.bestHead()
+	status := make([]EndpointStatus, len(p.endpoints))
+	for i, ep := range p.endpoints {
+		status[i] = EndpointStatus{
+			Url:          ep.client.Url,
+			Healthy:      ep.healthy,
+			HeadBlockNum: ep.head,
+			HeadLag:      best - ep.head,
+			ChainID:      ep.chainID,
+			Failures:     ep.failures,
+			LastError:    ep.lastErr,
+			LastPolled:   ep.lastPolled,
+		}
+	}
+	return status
+}
+
+// Endpoints to try in order, healthy endpoints with the least lag first.
+// Ejected endpoints are only included once EjectDuration has passed, or
+// if there are no other endpoints left.
+func (p *Pool) candidates() []*poolEndpoint {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	var available, ejected []*poolEndpoint
+	for _, ep := range p.endpoints {
+		if ep.healthy || now.Sub(ep.ejectedAt) >= p.EjectDuration {
+			available = append(available, ep)
+		} else {
+			ejected = append(ejected, ep)
+		}
+	}
+
+	sort.SliceStable(available, func(i, j int) bool {
+		if available[i].healthy != available[j].healthy {
+			return available[i].healthy
+		}
+		return available[i].head > available[j].head
+	})
+	if len(available) > 0 {
+		return available
+	}
+
+	// Nothing else left, try the endpoint that was ejected first.
+	sort.SliceStable(ejected, func(i, j int) bool {
+		return ejected[i].ejectedAt.Before(ejected[j].ejectedAt)
+	})
+	return ejected
+}
+
+// Limit each endpoint to rps requests per second, with bursts of up to burst requests.
+func (p *Pool) SetRateLimit(rps float64, burst int) *Pool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, ep := range p.endpoints {
+		ep.client.SetRateLimit(rps, burst)
+	}
+	return p
+}
+
+// Add interceptors to all endpoints.
+func (p *Pool) Use(interceptors ...Interceptor) *Pool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, ep := range p.endpoints {
+		ep.client.Use(interceptors...)
+	}
+	return p
+}
+
+// Use a custom http client for all endpoints.
+func (p *Pool) SetHTTPClient(hc *http.Client) *Pool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, ep := range p.endpoints {
+		ep.client.SetHTTPClient(hc)
+	}
+	return p
+}
+
+func (p *Pool) send(ctx context.Context, method string, path string, body interface{}, out interface{}, policy RetryPolicy) error {
+	candidates := p.candidates()
+	if len(candidates) < 1 {
+		return ErrNoEndpoints
+	}
+
+	// Failing over a push is a retry, the transaction might already have been accepted by the first endpoint.
+	if pushPaths[path] && !policy.RetryPushes {
+		candidates = candidates[:1]
+	}
+
+	var err error
+	for _, ep := range candidates {
+		err = ep.client.sendOnce(ctx, method, path, body, out, policy)
+		if err == nil || ctx.Err() != nil || !isFailoverError(err) {
+			if err == nil {
+				p.admit(ep)
+			}
+			return err
+		}
+		p.mu.Lock()
+		ep.eject(err, time.Now())
+		p.mu.Unlock()
+	}
+	return err
+}
+
+// Re-admit an ejected endpoint after a successful request,
+// unless it is on another chain.
+func (p *Pool) admit(ep *poolEndpoint) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !ep.healthy && (len(ep.chainID) < 1 || ep.chainID == p.ChainID) {
+		ep.healthy = true
+		ep.failures = 0
+		ep.lastErr = nil
+	}
+}
+
+func (p *Pool) bestHead() int64 {
+	var best int64
+	for _, ep := range p.endpoints {
+		if ep.healthy && ep.head > best {
+			best = ep.head
+		}
+	}
+	return best
+}
+
+func (ep *poolEndpoint) eject(err error, now time.Time) {
+	ep.healthy = false
+	ep.failures++
+	ep.ejectedAt = now
+	ep.lastErr = err
+}
+
+// Transport errors and generic 5xx responses are retried on the next endpoint.
+// Errors returned by nodeos (APIError) are not, the next node would fail in the same way.
+func isFailoverError(err error) bool {
+	var apiErr APIError
+	if errors.As(err, &apiErr) {
+		return false
+	}
+	var httpErr HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.Code >= 500
+	}
+	// Client side errors (encoding, decoding, interceptors) are not the endpoint's fault.
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
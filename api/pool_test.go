@@ -0,0 +1,248 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// test server that responds to get_info with chainID and head.
+func newInfoServer(chainID string, head *int64, hits *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		if hits != nil {
+			atomic.AddInt32(hits, 1)
+		}
+		_, _ = res.Write([]byte(fmt.Sprintf(`{"chain_id": "%s", "head_block_num": %d}`, chainID, atomic.LoadInt64(head))))
+	}))
+}
+
+func TestPoolFailover(t *testing.T) {
+	var badHits int32
+	bad := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&badHits, 1)
+		res.WriteHeader(http.StatusBadGateway)
+	}))
+	defer bad.Close()
+
+	head := int64(100)
+	good := newInfoServer("aca376f2", &head, nil)
+	defer good.Close()
+
+	pool := NewPool(bad.URL, good.URL)
+	client := pool.Client()
+
+	info, err := client.GetInfo(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), info.HeadBlockNum)
+
+	status := pool.Status()
+	assert.False(t, status[0].Healthy)
+	assert.Equal(t, 1, status[0].Failures)
+	assert.Equal(t, HTTPError{Code: http.StatusBadGateway}, status[0].LastError)
+	assert.True(t, status[1].Healthy)
+
+	// ejected endpoint is skipped.
+	_, err = client.GetInfo(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&badHits))
+}
+
+func TestPoolTransportError(t *testing.T) {
+	down := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {}))
+	down.Close()
+
+	head := int64(100)
+	good := newInfoServer("aca376f2", &head, nil)
+	defer good.Close()
+
+	pool := NewPool(down.URL, good.URL)
+	_, err := pool.Client().GetInfo(context.Background())
+	require.NoError(t, err)
+	assert.False(t, pool.Status()[0].Healthy)
+	assert.Error(t, pool.Status()[0].LastError)
+}
+
+func TestPoolNoFailoverOnAPIError(t *testing.T) {
+	failing := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(http.StatusInternalServerError)
+		_, _ = res.Write([]byte(`{"code": 500, "message": "Internal Service Error", "error": {"code": 3100002, "name": "unknown_block_exception"}}`))
+	}))
+	defer failing.Close()
+
+	var hits int32
+	head := int64(100)
+	other := newInfoServer("aca376f2", &head, &hits)
+	defer other.Close()
+
+	pool := NewPool(failing.URL, other.URL)
+	_, err := pool.Client().GetBlock(context.Background(), "1")
+	assert.ErrorIs(t, err, ErrUnknownBlock)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&hits))
+	assert.True(t, pool.Status()[0].Healthy)
+}
+
+func TestPoolNoFailoverOnDecodeError(t *testing.T) {
+	var hits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		_, _ = res.Write([]byte(`{"head_block_num": "not-a-number"}`))
+	}))
+	defer srv.Close()
+
+	pool := NewPool(srv.URL, srv.URL, srv.URL)
+	_, err := pool.Client().GetInfo(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&hits))
+	for _, status := range pool.Status() {
+		assert.True(t, status.Healthy)
+		assert.Equal(t, 0, status.Failures)
+	}
+}
+
+func TestPoolSkipsEjectedEndpoints(t *testing.T) {
+	var badHits int32
+	bad := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&badHits, 1)
+		res.WriteHeader(http.StatusBadGateway)
+	}))
+	defer bad.Close()
+
+	var otherHits int32
+	other := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&otherHits, 1)
+		res.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer other.Close()
+
+	pool := NewPool(bad.URL, other.URL)
+	pool.endpoints[0].eject(errors.New("down"), time.Now())
+
+	// The ejected endpoint is skipped while there are other endpoints, even if they fail.
+	_, err := pool.Client().GetInfo(context.Background())
+	assert.Equal(t, HTTPError{Code: http.StatusServiceUnavailable}, err)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&badHits))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&otherHits))
+
+	// Both are ejected now, so they are tried again.
+	_, err = pool.Client().GetInfo(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&badHits))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&otherHits))
+}
+
+func TestPoolAllEndpointsFail(t *testing.T) {
+	bad := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer bad.Close()
+
+	pool := NewPool(bad.URL, bad.URL)
+	_, err := pool.Client().GetInfo(context.Background())
+	assert.Equal(t, HTTPError{Code: http.StatusServiceUnavailable}, err)
+
+	_, err = NewPool().Client().GetInfo(context.Background())
+	assert.Equal(t, ErrNoEndpoints, err)
+}
+
+func TestPoolPoll(t *testing.T) {
+	headA, headB, headC := int64(1000), int64(900), int64(5000)
+
+	var hitsA, hitsB int32
+	a := newInfoServer("aca376f2", &headA, &hitsA)
+	defer a.Close()
+	b := newInfoServer("aca376f2", &headB, &hitsB)
+	defer b.Close()
+	c := newInfoServer("1064487b", &headC, nil)
+	defer c.Close()
+
+	pool := NewPool(b.URL, c.URL, a.URL)
+	pool.Poll(context.Background())
+
+	status := pool.Status()
+	assert.Equal(t, "aca376f2", pool.ChainID)
+	assert.False(t, status[0].Healthy, "b lags behind")
+	assert.Equal(t, int64(100), status[0].HeadLag)
+	assert.False(t, status[1].Healthy, "c is on another chain")
+	assert.True(t, status[2].Healthy)
+
+	// requests go to a
+	atomic.StoreInt32(&hitsA, 0)
+	atomic.StoreInt32(&hitsB, 0)
+	_, err := pool.Client().GetInfo(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&hitsA))
+	assert.Equal(t, int32(0), atomic.LoadInt32(&hitsB))
+
+	// b catches up and is re-admitted, c stays ejected
+	atomic.StoreInt64(&headB, 1001)
+	pool.Poll(context.Background())
+	status = pool.Status()
+	assert.True(t, status[0].Healthy)
+	assert.False(t, status[1].Healthy)
+	assert.True(t, status[2].Healthy)
+
+	// b has the highest head now
+	atomic.StoreInt32(&hitsA, 0)
+	atomic.StoreInt32(&hitsB, 0)
+	_, err = pool.Client().GetInfo(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&hitsA))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&hitsB))
+}
+
+func TestPoolReadmitAfterEjectDuration(t *testing.T) {
+	var fail int32 = 1
+	var hits int32
+	flaky := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		if atomic.LoadInt32(&fail) == 1 {
+			res.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = res.Write([]byte(`{"chain_id": "aca376f2", "head_block_num": 10}`))
+	}))
+	defer flaky.Close()
+
+	head := int64(10)
+	good := newInfoServer("aca376f2", &head, nil)
+	defer good.Close()
+
+	pool := NewPool(flaky.URL, good.URL)
+	pool.EjectDuration = 50 * time.Millisecond
+	client := pool.Client()
+
+	_, err := client.GetInfo(context.Background())
+	require.NoError(t, err)
+	assert.False(t, pool.Status()[0].Healthy)
+
+	atomic.StoreInt32(&fail, 0)
+	time.Sleep(60 * time.Millisecond)
+
+	// ejected endpoints are still tried after healthy ones, so make the healthy one fail.
+	good.Close()
+	_, err = client.GetInfo(context.Background())
+	require.NoError(t, err)
+	assert.True(t, pool.Status()[0].Healthy)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&hits))
+}
+
+func TestPoolClientSetRateLimit(t *testing.T) {
+	pool := NewPool("http://a.example", "http://b.example")
+	pool.Client().SetRateLimit(10, 1)
+	for _, ep := range pool.endpoints {
+		assert.NotNil(t, ep.client.limiter)
+	}
+
+	pool.Client().SetRateLimit(0, 0)
+	for _, ep := range pool.endpoints {
+		assert.Nil(t, ep.client.limiter)
+	}
+}
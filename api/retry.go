@@ -0,0 +1,154 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RetryPolicy controls how failed requests are retried.
+//
+// Only transport errors, HTTP 429 and generic 5xx responses are retried,
+// errors reported by nodeos (APIError) are returned directly.
+type RetryPolicy struct {
+	// Total number of attempts, values below 2 disables retries.
+	MaxAttempts int
+
+	// Backoff before the first retry, multiplied by Multiplier for each attempt and capped at MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Multiplier     float64
+
+	// Randomize the backoff by +/- this fraction (0.0 - 1.0).
+	Jitter float64
+
+	// Also retry transaction pushes. This is only safe if the caller
+	// can handle the transaction being applied by an earlier attempt.
+	RetryPushes bool
+}
+
+// Sensible defaults for idempotent reads.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    4,
+	InitialBackoff: 250 * time.Millisecond,
+	MaxBackoff:     5 * time.Second,
+	Multiplier:     2,
+	Jitter:         0.2,
+}
+
+// Endpoints that push transactions, they are not retried unless RetryPolicy.RetryPushes is set.
+var pushPaths = map[string]bool{
+	"/v1/chain/push_transaction":  true,
+	"/v1/chain/push_transactions": true,
+	"/v1/chain/send_transaction":  true,
+	"/v1/chain/send_transaction2": true,
+}
+
+type retryPolicyKey struct{}
+
+// Returns a context that overrides the client retry policy for calls made with it.
+func WithRetryPolicy(ctx context.Context, policy RetryPolicy) context.Context {
+	return context.WithValue(ctx, retryPolicyKey{}, policy)
+}
+
+func retryPolicyFromContext(ctx context.Context) (RetryPolicy, bool) {
+	policy, ok := ctx.Value(retryPolicyKey{}).(RetryPolicy)
+	return policy, ok
+}
+
+// Backoff before retry number attempt (starting at 0).
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	mul := p.Multiplier
+	if mul < 1 {
+		mul = 1
+	}
+	d := float64(p.InitialBackoff) * math.Pow(mul, float64(attempt))
+	if p.MaxBackoff > 0 && d > float64(p.MaxBackoff) {
+		d = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		d += d * p.Jitter * (rand.Float64()*2 - 1)
+	}
+	return time.Duration(d)
+}
+
+// Returns the time to wait before the next attempt, false if the request should not be retried.
+func (p RetryPolicy) next(attempt int, path string, err error) (time.Duration, bool) {
+	if attempt+1 >= p.MaxAttempts || !isRetryableError(err) {
+		return 0, false
+	}
+	if pushPaths[path] && !p.RetryPushes {
+		return 0, false
+	}
+
+	if d := retryAfter(err); d > 0 {
+		return d, true
+	}
+	return p.Backoff(attempt), true
+}
+
+// Returns the Retry-After duration sent with the error response, zero if there is none.
+func retryAfter(err error) time.Duration {
+	var apiErr APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.RetryAfter
+	}
+	var httpErr HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.RetryAfter
+	}
+	return 0
+}
+
+func isRetryableError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var apiErr APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Code == http.StatusTooManyRequests
+	}
+	var httpErr HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.Code == http.StatusTooManyRequests || httpErr.Code >= 500
+	}
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
+// Parse the Retry-After header, either in seconds or a http date.
+func parseRetryAfter(v string) time.Duration {
+	v = strings.TrimSpace(v)
+	if len(v) < 1 {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0
+		}
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
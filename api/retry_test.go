@@ -0,0 +1,218 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var fastRetryPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: time.Millisecond,
+	MaxBackoff:     10 * time.Millisecond,
+	Multiplier:     2,
+}
+
+// test server that fails the first n requests with status.
+func newFlakyServer(n int32, status int, hits *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		if atomic.AddInt32(hits, 1) <= n {
+			res.WriteHeader(status)
+			return
+		}
+		_, _ = res.Write([]byte(`{"chain_id": "aca376f2", "head_block_num": 10}`))
+	}))
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second, Multiplier: 2}
+	assert.Equal(t, 100*time.Millisecond, p.Backoff(0))
+	assert.Equal(t, 200*time.Millisecond, p.Backoff(1))
+	assert.Equal(t, 800*time.Millisecond, p.Backoff(3))
+	assert.Equal(t, time.Second, p.Backoff(10))
+
+	p.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		d := p.Backoff(1)
+		assert.True(t, d >= 100*time.Millisecond && d <= 300*time.Millisecond, "backoff out of range: %s", d)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	assert.Equal(t, time.Duration(0), parseRetryAfter(""))
+	assert.Equal(t, 2*time.Second, parseRetryAfter("2"))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("-1"))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("soon"))
+	d := parseRetryAfter(time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	assert.True(t, d > 50*time.Second && d <= time.Minute)
+}
+
+func TestSendNoRetryByDefault(t *testing.T) {
+	var hits int32
+	srv := newFlakyServer(1, http.StatusServiceUnavailable, &hits)
+	defer srv.Close()
+
+	_, err := New(srv.URL).GetInfo(context.Background())
+	assert.Equal(t, HTTPError{Code: http.StatusServiceUnavailable}, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&hits))
+}
+
+func TestSendRetry(t *testing.T) {
+	var hits int32
+	srv := newFlakyServer(2, http.StatusBadGateway, &hits)
+	defer srv.Close()
+
+	info, err := New(srv.URL).SetRetryPolicy(fastRetryPolicy).GetInfo(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), info.HeadBlockNum)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&hits))
+}
+
+func TestSendRetryGivesUp(t *testing.T) {
+	var hits int32
+	srv := newFlakyServer(10, http.StatusBadGateway, &hits)
+	defer srv.Close()
+
+	_, err := New(srv.URL).SetRetryPolicy(fastRetryPolicy).GetInfo(context.Background())
+	assert.Equal(t, HTTPError{Code: http.StatusBadGateway}, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&hits))
+}
+
+func TestSendRetryPerCall(t *testing.T) {
+	var hits int32
+	srv := newFlakyServer(1, http.StatusBadGateway, &hits)
+	defer srv.Close()
+
+	client := New(srv.URL).SetRetryPolicy(fastRetryPolicy)
+	_, err := client.GetInfo(WithRetryPolicy(context.Background(), RetryPolicy{}))
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&hits))
+
+	atomic.StoreInt32(&hits, 0)
+	_, err = New(srv.URL).GetInfo(WithRetryPolicy(context.Background(), fastRetryPolicy))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&hits))
+}
+
+func TestSendRetryNotOnAPIError(t *testing.T) {
+	var hits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		res.WriteHeader(http.StatusInternalServerError)
+		_, _ = res.Write([]byte(`{"code": 500, "message": "Internal Service Error", "error": {"code": 3100002, "name": "unknown_block_exception"}}`))
+	}))
+	defer srv.Close()
+
+	_, err := New(srv.URL).SetRetryPolicy(fastRetryPolicy).GetBlock(context.Background(), "1")
+	assert.ErrorIs(t, err, ErrUnknownBlock)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&hits))
+}
+
+func TestSendRetryTransportError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {}))
+	srv.Close()
+
+	start := time.Now()
+	_, err := New(srv.URL).SetRetryPolicy(RetryPolicy{MaxAttempts: 3, InitialBackoff: 20 * time.Millisecond}).GetInfo(context.Background())
+	assert.Error(t, err)
+	assert.True(t, time.Since(start) >= 40*time.Millisecond)
+}
+
+func TestSendNoRetryPush(t *testing.T) {
+	var hits int32
+	srv := newFlakyServer(1, http.StatusBadGateway, &hits)
+	defer srv.Close()
+
+	client := New(srv.URL).SetRetryPolicy(fastRetryPolicy)
+	_, err := client.SendTransaction(context.Background(), packedTestTransaction)
+	assert.Equal(t, HTTPError{Code: http.StatusBadGateway}, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&hits))
+
+	// opt in
+	atomic.StoreInt32(&hits, 0)
+	policy := fastRetryPolicy
+	policy.RetryPushes = true
+	_, err = client.SendTransaction(WithRetryPolicy(context.Background(), policy), packedTestTransaction)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&hits))
+}
+
+func TestSendRetryAfter(t *testing.T) {
+	var hits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		if atomic.AddInt32(&hits, 1) == 1 {
+			res.Header().Set("Retry-After", "1")
+			res.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = res.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	start := time.Now()
+	_, err := New(srv.URL).SetRetryPolicy(fastRetryPolicy).GetInfo(context.Background())
+	require.NoError(t, err)
+	assert.True(t, time.Since(start) >= time.Second, "Retry-After was not respected")
+	assert.Equal(t, int32(2), atomic.LoadInt32(&hits))
+}
+
+func TestSendRetryAfterAPIError(t *testing.T) {
+	var hits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		if atomic.AddInt32(&hits, 1) == 1 {
+			res.Header().Set("Retry-After", "1")
+			res.WriteHeader(http.StatusTooManyRequests)
+			_, _ = res.Write([]byte(`{"code": 429, "message": "Too Many Requests"}`))
+			return
+		}
+		_, _ = res.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	start := time.Now()
+	_, err := New(srv.URL).SetRetryPolicy(fastRetryPolicy).GetInfo(context.Background())
+	require.NoError(t, err)
+	assert.True(t, time.Since(start) >= time.Second, "Retry-After was not respected")
+	assert.Equal(t, int32(2), atomic.LoadInt32(&hits))
+}
+
+func TestSendRetryContextCancel(t *testing.T) {
+	var hits int32
+	srv := newFlakyServer(10, http.StatusBadGateway, &hits)
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	policy := RetryPolicy{MaxAttempts: 10, InitialBackoff: time.Second}
+	_, err := New(srv.URL).SetRetryPolicy(policy).GetInfo(ctx)
+	assert.Equal(t, HTTPError{Code: http.StatusBadGateway}, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&hits))
+}
+
+func TestSendRateLimit(t *testing.T) {
+	var hits int32
+	srv := newFlakyServer(0, 0, &hits)
+	defer srv.Close()
+
+	client := New(srv.URL).SetRateLimit(20, 1)
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		_, err := client.GetInfo(context.Background())
+		require.NoError(t, err)
+	}
+	// first request uses the burst, the others wait 50ms each.
+	assert.True(t, time.Since(start) >= 90*time.Millisecond, "requests were not rate limited")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := client.GetInfo(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&hits))
+}
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"context"
+
+	"github.com/shufflingpixels/antelope-go/chain"
+)
+
+type SendTransactionResp struct {
+	TransactionID chain.Checksum256 `json:"transaction_id"`
+	Processed     TransactionTrace  `json:"processed"`
+}
+
+type SendTransaction2Options struct {
+	// Return the trace of a failed transaction instead of an error.
+	ReturnFailureTrace bool
+	// Keep retrying the transaction until it is irreversible or expired.
+	RetryTrx bool
+	// Retry until the transaction is included in a block this number of blocks
+	// deep instead of irreversible, zero means use the node's default.
+	RetryTrxNumBlocks uint32
+}
+
+type sendTransaction2Req struct {
+	ReturnFailureTrace bool                     `json:"return_failure_trace"`
+	RetryTrx           bool                     `json:"retry_trx"`
+	RetryTrxNumBlocks  uint32                   `json:"retry_trx_num_blocks,omitempty"`
+	Transaction        *chain.PackedTransaction `json:"transaction"`
+}
+
+//	PushTransaction - Posts to "/v1/chain/push_transaction"
+//
+// ---------------------------------------------------------
+func (c *Client) PushTransaction(ctx context.Context, tx *chain.PackedTransaction) (resp SendTransactionResp, err error) {
+	err = c.send(ctx, "POST", "/v1/chain/push_transaction", tx, &resp)
+	return
+}
+
+//	SendTransaction - Posts to "/v1/chain/send_transaction"
+//
+// ---------------------------------------------------------
+func (c *Client) SendTransaction(ctx context.Context, tx *chain.PackedTransaction) (resp SendTransactionResp, err error) {
+	err = c.send(ctx, "POST", "/v1/chain/send_transaction", tx, &resp)
+	return
+}
+
+//	SendTransaction2 - Posts to "/v1/chain/send_transaction2"
+//
+// ---------------------------------------------------------
+func (c *Client) SendTransaction2(ctx context.Context, tx *chain.PackedTransaction, opts SendTransaction2Options) (resp SendTransactionResp, err error) {
+	body := sendTransaction2Req{
+		ReturnFailureTrace: opts.ReturnFailureTrace,
+		RetryTrx:           opts.RetryTrx,
+		RetryTrxNumBlocks:  opts.RetryTrxNumBlocks,
+		Transaction:        tx,
+	}
+	err = c.send(ctx, "POST", "/v1/chain/send_transaction2", body, &resp)
+	return
+}
+
+//	ComputeTransaction - Posts to "/v1/chain/compute_transaction"
+//
+// Executes the transaction without committing it to the chain.
+//
+// ---------------------------------------------------------
+func (c *Client) ComputeTransaction(ctx context.Context, tx *chain.PackedTransaction) (resp SendTransactionResp, err error) {
+	body := map[string]interface{}{
+		"transaction": tx,
+	}
+
+	err = c.send(ctx, "POST", "/v1/chain/compute_transaction", body, &resp)
+	return
+}
@@ -0,0 +1,242 @@
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/shufflingpixels/antelope-go/chain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sendTransactionResp = `{
+	"transaction_id": "7f84ff0d833c5965f73fb9651881c5b233ab07b45b1a2646b82946f66b78ff92",
+	"processed": {
+		"id": "7f84ff0d833c5965f73fb9651881c5b233ab07b45b1a2646b82946f66b78ff92",
+		"block_num": 95309425,
+		"block_time": "2022-12-22T13:56:03.500",
+		"producer_block_id": null,
+		"receipt": {
+			"status": "executed",
+			"cpu_usage_us": 181,
+			"net_usage_words": 16
+		},
+		"elapsed": 181,
+		"net_usage": 128,
+		"scheduled": false,
+		"action_traces": [
+			{
+				"action_ordinal": 1,
+				"creator_action_ordinal": 0,
+				"closest_unnotified_ancestor_action_ordinal": 0,
+				"receipt": {
+					"receiver": "eosio.token",
+					"act_digest": "a2256be7d32e4d3a7c6c2e1bbd4a1a1b0ab7dbfcae8d03e5b7fd0f1f1b3d3d49",
+					"global_sequence": 361658128421,
+					"recv_sequence": 42,
+					"auth_sequence": [["alice", 7]],
+					"code_sequence": 1,
+					"abi_sequence": 2
+				},
+				"receiver": "eosio.token",
+				"act": {
+					"account": "eosio.token",
+					"name": "transfer",
+					"authorization": [{"actor": "alice", "permission": "active"}],
+					"data": {"from": "alice", "to": "bob", "quantity": "1.0000 EOS", "memo": ""},
+					"hex_data": "deadbeef"
+				},
+				"context_free": false,
+				"elapsed": 95,
+				"console": "hello ",
+				"trx_id": "7f84ff0d833c5965f73fb9651881c5b233ab07b45b1a2646b82946f66b78ff92",
+				"block_num": 95309425,
+				"block_time": "2022-12-22T13:56:03.500",
+				"producer_block_id": null,
+				"account_ram_deltas": [{"account": "bob", "delta": 240}],
+				"except": null,
+				"error_code": null,
+				"return_value_hex_data": ""
+			},
+			{
+				"action_ordinal": 2,
+				"creator_action_ordinal": 1,
+				"closest_unnotified_ancestor_action_ordinal": 1,
+				"receipt": null,
+				"receiver": "bob",
+				"act": {
+					"account": "eosio.token",
+					"name": "transfer",
+					"authorization": [],
+					"data": "beef"
+				},
+				"context_free": false,
+				"elapsed": 3,
+				"console": "world",
+				"trx_id": "7f84ff0d833c5965f73fb9651881c5b233ab07b45b1a2646b82946f66b78ff92",
+				"block_num": 95309425,
+				"block_time": "2022-12-22T13:56:03.500",
+				"producer_block_id": null,
+				"account_ram_deltas": [],
+				"except": null,
+				"error_code": null
+			}
+		],
+		"account_ram_delta": null,
+		"except": null,
+		"error_code": null
+	}
+}`
+
+const sendTransactionFailureResp = `{
+	"transaction_id": "7f84ff0d833c5965f73fb9651881c5b233ab07b45b1a2646b82946f66b78ff92",
+	"processed": {
+		"id": "7f84ff0d833c5965f73fb9651881c5b233ab07b45b1a2646b82946f66b78ff92",
+		"block_num": 95309425,
+		"block_time": "2022-12-22T13:56:03.500",
+		"producer_block_id": null,
+		"receipt": null,
+		"elapsed": 0,
+		"net_usage": 0,
+		"scheduled": false,
+		"action_traces": [],
+		"account_ram_delta": null,
+		"except": {
+			"code": 3050003,
+			"name": "eosio_assert_message_exception",
+			"message": "eosio_assert_message assertion failure",
+			"stack": [
+				{
+					"context": {
+						"level": "error",
+						"file": "cf_system.cpp",
+						"line": 14,
+						"method": "eosio_assert",
+						"hostname": "",
+						"thread_name": "nodeos",
+						"timestamp": "2022-12-22T13:56:03.512"
+					},
+					"format": "assertion failure with message: ${s}",
+					"data": {"s": "overdrawn balance"}
+				}
+			]
+		},
+		"error_code": "10000000000000000000"
+	}
+}`
+
+var packedTestTransaction = &chain.PackedTransaction{
+	Signatures:            []chain.Signature{},
+	Compression:           chain.CompressionNone,
+	PackedContextFreeData: chain.Bytes{},
+	PackedTransaction:     chain.Bytes{0xde, 0xad, 0xbe, 0xef},
+}
+
+func TestPushTransaction(t *testing.T) {
+	for _, path := range []string{"/v1/chain/push_transaction", "/v1/chain/send_transaction"} {
+		srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, path, req.URL.String())
+			body, err := io.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{
+				"signatures": [],
+				"compression": "none",
+				"packed_context_free_data": "",
+				"packed_trx": "deadbeef"
+			}`, string(body))
+			_, _ = res.Write([]byte(sendTransactionResp))
+		}))
+
+		client := New(srv.URL)
+
+		var resp SendTransactionResp
+		var err error
+		if path == "/v1/chain/push_transaction" {
+			resp, err = client.PushTransaction(context.Background(), packedTestTransaction)
+		} else {
+			resp, err = client.SendTransaction(context.Background(), packedTestTransaction)
+		}
+		require.NoError(t, err)
+		srv.Close()
+
+		trace := resp.Processed
+		assert.Equal(t, "7f84ff0d833c5965f73fb9651881c5b233ab07b45b1a2646b82946f66b78ff92", resp.TransactionID.String())
+		assert.Equal(t, resp.TransactionID, trace.ID)
+		assert.Equal(t, chain.BlockNum(95309425), trace.BlockNum)
+		assert.Equal(t, time.Date(2022, 12, 22, 13, 56, 3, 500000000, time.UTC), trace.BlockTime)
+		assert.Nil(t, trace.ProducerBlockID)
+		assert.Equal(t, &chain.TransactionReceiptHeader{
+			Status:               chain.TransactionStatusExecuted,
+			CPUUsageMicroSeconds: 181,
+			NetUsageWords:        16,
+		}, trace.Receipt)
+		assert.Nil(t, trace.Except)
+		assert.Equal(t, "hello world", trace.Console())
+		require.Len(t, trace.ActionTraces, 2)
+
+		at := trace.ActionTraces[0]
+		assert.Equal(t, uint(1), at.ActionOrdinal)
+		assert.Equal(t, chain.N("eosio.token"), at.Receiver)
+		require.NotNil(t, at.Receipt)
+		assert.Equal(t, chain.Uint64(361658128421), at.Receipt.GlobalSequence)
+		assert.Equal(t, []AuthSequence{{Account: chain.N("alice"), Sequence: 7}}, at.Receipt.AuthSequence)
+		assert.Equal(t, []AccountRamDelta{{Account: chain.N("bob"), Delta: 240}}, at.AccountRamDeltas)
+		assert.Equal(t, map[string]interface{}{"from": "alice", "to": "bob", "quantity": "1.0000 EOS", "memo": ""}, at.Act.Data)
+
+		act, err := at.Act.Action()
+		require.NoError(t, err)
+		assert.Equal(t, chain.Bytes{0xde, 0xad, 0xbe, 0xef}, act.Data)
+		assert.Equal(t, chain.N("transfer"), act.Name)
+
+		act, err = trace.ActionTraces[1].Act.Action()
+		require.NoError(t, err)
+		assert.Equal(t, chain.Bytes{0xbe, 0xef}, act.Data)
+		assert.Nil(t, trace.ActionTraces[1].Receipt)
+	}
+}
+
+func TestSendTransaction2(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/v1/chain/send_transaction2", req.URL.String())
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{
+			"return_failure_trace": true,
+			"retry_trx": true,
+			"retry_trx_num_blocks": 10,
+			"transaction": {
+				"signatures": [],
+				"compression": "none",
+				"packed_context_free_data": "",
+				"packed_trx": "deadbeef"
+			}
+		}`, string(body))
+		_, _ = res.Write([]byte(sendTransactionFailureResp))
+	}))
+	defer srv.Close()
+
+	client := New(srv.URL)
+
+	resp, err := client.SendTransaction2(context.Background(), packedTestTransaction, SendTransaction2Options{
+		ReturnFailureTrace: true,
+		RetryTrx:           true,
+		RetryTrxNumBlocks:  10,
+	})
+	require.NoError(t, err)
+
+	trace := resp.Processed
+	assert.Nil(t, trace.Receipt)
+	require.NotNil(t, trace.Except)
+	assert.Equal(t, int64(3050003), trace.Except.Code)
+	assert.Equal(t, "eosio_assert_message_exception", trace.Except.Name)
+	assert.Equal(t, "eosio_assert_message_exception: eosio_assert_message assertion failure", trace.Except.Error())
+	require.Len(t, trace.Except.Stack, 1)
+	assert.Equal(t, "cf_system.cpp", trace.Except.Stack[0].Context.File)
+	assert.Equal(t, map[string]interface{}{"s": "overdrawn balance"}, trace.Except.Stack[0].Data)
+	require.NotNil(t, trace.ErrorCode)
+	assert.Equal(t, chain.Uint64(10000000000000000000), *trace.ErrorCode)
+}
@@ -0,0 +1,104 @@
+package api
+
+import (
+	"context"
+
+	"github.com/shufflingpixels/antelope-go/chain"
+)
+
+// Endpoints of the trace api plugin.
+
+// Authorization of an action, the trace api names the actor "account".
+type BlockTraceAuthorization struct {
+	Account    chain.Name `json:"account"`
+	Permission chain.Name `json:"permission"`
+}
+
+type BlockTraceAction struct {
+	GlobalSequence                         chain.Uint64              `json:"global_sequence"`
+	Receiver                               chain.Name                `json:"receiver"`
+	Account                                chain.Name                `json:"account"`
+	Action                                 chain.Name                `json:"action"`
+	Authorization                          []BlockTraceAuthorization `json:"authorization"`
+	Data                                   chain.Bytes               `json:"data"`
+	ReturnValue                            chain.Bytes               `json:"return_value"`
+	ActionOrdinal                          uint                      `json:"action_ordinal,omitempty"`
+	CreatorActionOrdinal                   uint                      `json:"creator_action_ordinal,omitempty"`
+	ClosestUnnotifiedAncestorActionOrdinal uint                      `json:"closest_unnotified_ancestor_action_ordinal,omitempty"`
+	// ABI decoded data, only present if nodeos has the abi for the account.
+	Params interface{} `json:"params,omitempty"`
+	// ABI decoded return value, only present if nodeos has the abi for the account.
+	ReturnData interface{} `json:"return_data,omitempty"`
+}
+
+type BlockTraceTransaction struct {
+	ID                chain.Checksum256       `json:"id"`
+	Actions           []BlockTraceAction      `json:"actions"`
+	Status            chain.TransactionStatus `json:"status"`
+	CPUUsageUS        uint32                  `json:"cpu_usage_us"`
+	NetUsageWords     uint32                  `json:"net_usage_words"`
+	Signatures        []chain.Signature       `json:"signatures"`
+	TransactionHeader chain.TransactionHeader `json:"transaction_header"`
+	BillToAccounts    []chain.Name            `json:"bill_to_accounts,omitempty"`
+	// Only set by "/v1/trace_api/get_transaction_trace".
+	BlockNum        chain.BlockNum       `json:"block_num,omitempty"`
+	BlockTime       chain.BlockTimestamp `json:"block_time,omitempty"`
+	ProducerBlockID *chain.Checksum256   `json:"producer_block_id,omitempty"`
+}
+
+// BlockTrace - Struct for "/v1/trace_api/get_block" API
+type BlockTrace struct {
+	ID               chain.Checksum256       `json:"id"`
+	Number           chain.BlockNum          `json:"number"`
+	PreviousID       chain.Checksum256       `json:"previous_id"`
+	Status           string                  `json:"status"`
+	Timestamp        chain.BlockTimestamp    `json:"timestamp"`
+	Producer         chain.Name              `json:"producer"`
+	TransactionMRoot chain.Checksum256       `json:"transaction_mroot"`
+	ActionMRoot      chain.Checksum256       `json:"action_mroot"`
+	ScheduleVersion  uint32                  `json:"schedule_version"`
+	Transactions     []BlockTraceTransaction `json:"transactions"`
+}
+
+// Returns true if the block is irreversible, otherwise the block is still "pending".
+func (b BlockTrace) Irreversible() bool {
+	return b.Status == "irreversible"
+}
+
+// Returns the raw action.
+func (a BlockTraceAction) ChainAction() *chain.Action {
+	auth := make([]chain.PermissionLevel, len(a.Authorization))
+	for i, pl := range a.Authorization {
+		auth[i] = chain.PermissionLevel{Actor: pl.Account, Permission: pl.Permission}
+	}
+	return chain.NewAction(a.Account, a.Action, auth, a.Data)
+}
+
+// Decode the raw action data with abi, useful if nodeos did not return params.
+func (a BlockTraceAction) Decode(abi *chain.Abi) (map[string]interface{}, error) {
+	return a.ChainAction().Decode(abi)
+}
+
+//	GetBlockTrace - Fetches "/v1/trace_api/get_block" from API
+//
+// ---------------------------------------------------------
+func (c *Client) GetBlockTrace(ctx context.Context, num chain.BlockNum) (block BlockTrace, err error) {
+	body := map[string]interface{}{
+		"block_num": num,
+	}
+
+	err = c.send(ctx, "POST", "/v1/trace_api/get_block", body, &block)
+	return
+}
+
+//	GetTransactionTrace - Fetches "/v1/trace_api/get_transaction_trace" from API
+//
+// ---------------------------------------------------------
+func (c *Client) GetTransactionTrace(ctx context.Context, id chain.Checksum256) (trx BlockTraceTransaction, err error) {
+	body := map[string]interface{}{
+		"id": id,
+	}
+
+	err = c.send(ctx, "POST", "/v1/trace_api/get_transaction_trace", body, &trx)
+	return
+}
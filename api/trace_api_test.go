@@ -0,0 +1,134 @@
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/shufflingpixels/antelope-go/chain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const blockTraceTransaction = `{
+	"id": "3e8ee9e3a5bd5ea0e0b1d4bd6c84faaf5d5fcf7cd42cc0c8d7e3c06f4ad2de60",
+	"actions": [
+		{
+			"global_sequence": 5368709120,
+			"receiver": "eosio.token",
+			"account": "eosio.token",
+			"action": "transfer",
+			"authorization": [{"account": "alice", "permission": "active"}],
+			"data": "` + transferHexData + `",
+			"return_value": "",
+			"params": {"from": "alice", "to": "bob", "quantity": "1.0000 EOS", "memo": "hi"}
+		},
+		{
+			"global_sequence": 5368709121,
+			"receiver": "bob",
+			"account": "eosio.token",
+			"action": "transfer",
+			"authorization": [{"account": "alice", "permission": "active"}],
+			"data": "` + transferHexData + `",
+			"return_value": ""
+		}
+	],
+	"status": "executed",
+	"cpu_usage_us": 150,
+	"net_usage_words": 16,
+	"signatures": ["SIG_K1_KVGMr8LbsJrMS5hPG6xhhsAWZ8BGmcEhYN6iGFQiz8piBSR4fZcu1NqAX75STz6V99LSDomx4jo4bVxrGTW4FNbeRBNY1R"],
+	"transaction_header": {
+		"expiration": "2024-03-01T10:00:30",
+		"ref_block_num": 11,
+		"ref_block_prefix": 3704406,
+		"max_net_usage_words": 0,
+		"max_cpu_usage_ms": 0,
+		"delay_sec": 0
+	},
+	"bill_to_accounts": ["alice"]
+}`
+
+func TestGetBlockTrace(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/v1/trace_api/get_block", req.URL.String())
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"block_num": 107}`, string(body))
+		_, _ = res.Write([]byte(`{
+			"id": "0000006b2d5c3b1f4a0d0e3a5cfd4c6c8be8c1b1d0f1a19ca0ecf6ad8e4c2b3a",
+			"number": 107,
+			"previous_id": "0000006a9b67c0e10bc95de4ae8b1c4b4e7a3d42a6e6cf1ef5af6b7f35d3c5ff",
+			"status": "irreversible",
+			"timestamp": "2024-03-01T10:00:00.500Z",
+			"producer": "eosio",
+			"transaction_mroot": "0000000000000000000000000000000000000000000000000000000000000000",
+			"action_mroot": "0000000000000000000000000000000000000000000000000000000000000000",
+			"schedule_version": 3,
+			"transactions": [` + blockTraceTransaction + `]
+		}`))
+	}))
+	defer srv.Close()
+
+	block, err := New(srv.URL).GetBlockTrace(context.Background(), 107)
+	require.NoError(t, err)
+	assert.Equal(t, chain.BlockNum(107), block.Number)
+	assert.True(t, block.Irreversible())
+	assert.Equal(t, time.Date(2024, 3, 1, 10, 0, 0, 500000000, time.UTC), block.Timestamp.Time().UTC())
+	assert.Equal(t, chain.N("eosio"), block.Producer)
+	assert.Equal(t, uint32(3), block.ScheduleVersion)
+	require.Len(t, block.Transactions, 1)
+
+	trx := block.Transactions[0]
+	assert.Equal(t, chain.TransactionStatusExecuted, trx.Status)
+	assert.Equal(t, uint32(150), trx.CPUUsageUS)
+	assert.Equal(t, uint16(11), trx.TransactionHeader.RefBlockNum)
+	assert.Equal(t, []chain.Name{chain.N("alice")}, trx.BillToAccounts)
+	require.Len(t, trx.Actions, 2)
+
+	// decoded by nodeos
+	act := trx.Actions[0]
+	assert.Equal(t, chain.Uint64(5368709120), act.GlobalSequence)
+	assert.Equal(t, chain.N("transfer"), act.Action)
+	assert.Equal(t, map[string]interface{}{"from": "alice", "to": "bob", "quantity": "1.0000 EOS", "memo": "hi"}, act.Params)
+
+	// decoded locally
+	act = trx.Actions[1]
+	assert.Nil(t, act.Params)
+	assert.Equal(t, &chain.Action{
+		Account:       chain.N("eosio.token"),
+		Name:          chain.N("transfer"),
+		Authorization: []chain.PermissionLevel{{Actor: chain.N("alice"), Permission: chain.N("active")}},
+		Data:          act.Data,
+	}, act.ChainAction())
+	data, err := act.Decode(&tokenAbi)
+	require.NoError(t, err)
+	assert.Equal(t, *chain.A("1.0000 EOS"), data["quantity"])
+}
+
+func TestGetTransactionTrace(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/v1/trace_api/get_transaction_trace", req.URL.String())
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"id": "3e8ee9e3a5bd5ea0e0b1d4bd6c84faaf5d5fcf7cd42cc0c8d7e3c06f4ad2de60"}`, string(body))
+		_, _ = res.Write([]byte(blockTraceTransaction[:len(blockTraceTransaction)-1] + `,
+			"block_num": 107,
+			"block_time": "2024-03-01T10:00:00.500Z",
+			"producer_block_id": "0000006b2d5c3b1f4a0d0e3a5cfd4c6c8be8c1b1d0f1a19ca0ecf6ad8e4c2b3a"
+		}`))
+	}))
+	defer srv.Close()
+
+	var id chain.Checksum256
+	require.NoError(t, id.UnmarshalText([]byte("3e8ee9e3a5bd5ea0e0b1d4bd6c84faaf5d5fcf7cd42cc0c8d7e3c06f4ad2de60")))
+	trx, err := New(srv.URL).GetTransactionTrace(context.Background(), id)
+	require.NoError(t, err)
+	assert.Equal(t, id, trx.ID)
+	assert.Equal(t, chain.BlockNum(107), trx.BlockNum)
+	require.NotNil(t, trx.ProducerBlockID)
+	assert.Equal(t, "0000006b2d5c3b1f4a0d0e3a5cfd4c6c8be8c1b1d0f1a19ca0ecf6ad8e4c2b3a", trx.ProducerBlockID.String())
+	require.Len(t, trx.Actions, 2)
+}
@@ -0,0 +1,95 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/shufflingpixels/antelope-go/chain"
+)
+
+// Default expiration window of transactions created by TransactionBuilder.
+const DefaultTransactionExpiration = 30 * time.Second
+
+// TransactionBuilder creates transactions with TAPoS
+// and expiration derived from "/v1/chain/get_info"
+type TransactionBuilder struct {
+	client *Client
+
+	// Expiration window, relative to the head block time.
+	Expiration time.Duration
+
+	// Reference the head block instead of the last irreversible block.
+	UseHeadBlock bool
+
+	MaxNetUsageWords uint
+	MaxCpuUsageMs    uint8
+	DelaySec         uint
+
+	contextFreeActions []chain.Action
+	actions            []chain.Action
+}
+
+func (c *Client) NewTransactionBuilder() *TransactionBuilder {
+	return &TransactionBuilder{
+		client:     c,
+		Expiration: DefaultTransactionExpiration,
+	}
+}
+
+func (b *TransactionBuilder) SetExpiration(d time.Duration) *TransactionBuilder {
+	b.Expiration = d
+	return b
+}
+
+func (b *TransactionBuilder) SetUseHeadBlock(v bool) *TransactionBuilder {
+	b.UseHeadBlock = v
+	return b
+}
+
+func (b *TransactionBuilder) AddAction(actions ...chain.Action) *TransactionBuilder {
+	b.actions = append(b.actions, actions...)
+	return b
+}
+
+func (b *TransactionBuilder) AddContextFreeAction(actions ...chain.Action) *TransactionBuilder {
+	b.contextFreeActions = append(b.contextFreeActions, actions...)
+	return b
+}
+
+// Build fetches the chain info and returns a transaction that is ready to be signed.
+func (b *TransactionBuilder) Build(ctx context.Context) (*chain.Transaction, error) {
+	info, err := b.client.GetInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return b.BuildWithInfo(info)
+}
+
+// BuildWithInfo is like Build but uses an already fetched Info.
+func (b *TransactionBuilder) BuildWithInfo(info Info) (*chain.Transaction, error) {
+	blockID := info.LastIrreversableBlockID
+	if b.UseHeadBlock || len(blockID) < 1 {
+		blockID = info.HeadBlockID
+	}
+
+	var id chain.Checksum256
+	if err := id.UnmarshalText([]byte(blockID)); err != nil {
+		return nil, err
+	}
+
+	tx := &chain.Transaction{
+		TransactionHeader: chain.TransactionHeader{
+			Expiration:       chain.NewTimePointSec(info.HeadBlockTime.Add(b.Expiration)),
+			MaxNetUsageWords: b.MaxNetUsageWords,
+			MaxCpuUsageMs:    b.MaxCpuUsageMs,
+			DelaySec:         b.DelaySec,
+		},
+		ContextFreeActions: []chain.Action{},
+		Actions:            []chain.Action{},
+		Extensions:         []chain.TransactionExtension{},
+	}
+	tx.SetReferenceBlock(id)
+	tx.ContextFreeActions = append(tx.ContextFreeActions, b.contextFreeActions...)
+	tx.Actions = append(tx.Actions, b.actions...)
+	return tx, nil
+}
@@ -0,0 +1,69 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/shufflingpixels/antelope-go/chain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionBuilder(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		if req.URL.String() == "/v1/chain/get_info" {
+			info := `{
+				"head_block_id": "05ae4e7033dc0138bb3bede566d57cc7783ba1a091388c6961e93c0ef37476dc",
+				"head_block_num": 95309424,
+				"head_block_time": "2022-12-22T13:56:03",
+				"last_irreversible_block_num": 95309332,
+				"last_irreversible_block_id": "05ae4e14d2c8d65eb6364cf65f588761f8e8156f9a2ef6b9f53fab1609066f9c"
+			}`
+			_, _ = res.Write([]byte(info))
+		}
+	}))
+
+	client := New(testServer.URL)
+
+	action := chain.Action{
+		Account: chain.N("eosio.token"),
+		Name:    chain.N("transfer"),
+		Authorization: []chain.PermissionLevel{
+			{Actor: chain.N("alice"), Permission: chain.N("active")},
+		},
+		Data: []byte{0xde, 0xad, 0xbe, 0xef},
+	}
+
+	tx, err := client.NewTransactionBuilder().
+		AddAction(action).
+		Build(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, uint16(0x4e14), tx.RefBlockNum)
+	assert.Equal(t, uint32(0xf64c36b6), tx.RefBlockPrefix)
+	assert.Equal(t, time.Date(2022, 12, 22, 13, 56, 33, 0, time.UTC), tx.Expiration.Time().UTC())
+	assert.Equal(t, []chain.Action{}, tx.ContextFreeActions)
+	assert.Equal(t, []chain.Action{action}, tx.Actions)
+	assert.Equal(t, []chain.TransactionExtension{}, tx.Extensions)
+
+	tx, err = client.NewTransactionBuilder().
+		SetExpiration(time.Hour).
+		SetUseHeadBlock(true).
+		AddAction(action).
+		Build(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, uint16(0x4e70), tx.RefBlockNum)
+	assert.Equal(t, uint32(0xe5ed3bbb), tx.RefBlockPrefix)
+	assert.Equal(t, time.Date(2022, 12, 22, 14, 56, 3, 0, time.UTC), tx.Expiration.Time().UTC())
+}
+
+func TestTransactionBuilderInvalidBlockID(t *testing.T) {
+	b := New("http://localhost").NewTransactionBuilder()
+
+	_, err := b.BuildWithInfo(Info{HeadBlockID: "invalid"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,155 @@
+package api
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/shufflingpixels/antelope-go/chain"
+)
+
+// AuthSequence is encoded as a [account, sequence] tuple by nodeos.
+type AuthSequence struct {
+	Account  chain.Name
+	Sequence chain.Uint64
+}
+
+type ActionReceipt struct {
+	Receiver       chain.Name        `json:"receiver"`
+	ActDigest      chain.Checksum256 `json:"act_digest"`
+	GlobalSequence chain.Uint64      `json:"global_sequence"`
+	RecvSequence   chain.Uint64      `json:"recv_sequence"`
+	AuthSequence   []AuthSequence    `json:"auth_sequence"`
+	CodeSequence   uint              `json:"code_sequence"`
+	AbiSequence    uint              `json:"abi_sequence"`
+}
+
+// TraceAction is the action as returned in traces. Data is the ABI decoded
+// action data if nodeos could decode it, otherwise the hex string.
+type TraceAction struct {
+	Account       chain.Name              `json:"account"`
+	Name          chain.Name              `json:"name"`
+	Authorization []chain.PermissionLevel `json:"authorization"`
+	Data          interface{}             `json:"data"`
+	HexData       chain.Bytes             `json:"hex_data,omitempty"`
+}
+
+type AccountRamDelta struct {
+	Account chain.Name `json:"account"`
+	Delta   int64      `json:"delta"`
+}
+
+type ExceptionContext struct {
+	Level      string    `json:"level"`
+	File       string    `json:"file"`
+	Line       int64     `json:"line"`
+	Method     string    `json:"method"`
+	Hostname   string    `json:"hostname"`
+	ThreadName string    `json:"thread_name"`
+	Timestamp  time.Time `json:"timestamp" time_format:"antelope-api" time_location:"antelope-api"`
+}
+
+type ExceptionStack struct {
+	Context ExceptionContext       `json:"context"`
+	Format  string                 `json:"format"`
+	Data    map[string]interface{} `json:"data"`
+}
+
+// Exception - fc::exception as it is included in traces.
+type Exception struct {
+	Code    int64            `json:"code"`
+	Name    string           `json:"name"`
+	Message string           `json:"message"`
+	Stack   []ExceptionStack `json:"stack"`
+}
+
+type ActionTrace struct {
+	ActionOrdinal                          uint               `json:"action_ordinal"`
+	CreatorActionOrdinal                   uint               `json:"creator_action_ordinal"`
+	ClosestUnnotifiedAncestorActionOrdinal uint               `json:"closest_unnotified_ancestor_action_ordinal"`
+	Receipt                                *ActionReceipt     `json:"receipt"`
+	Receiver                               chain.Name         `json:"receiver"`
+	Act                                    TraceAction        `json:"act"`
+	ContextFree                            bool               `json:"context_free"`
+	Elapsed                                int64              `json:"elapsed"`
+	Console                                string             `json:"console"`
+	TrxID                                  chain.Checksum256  `json:"trx_id"`
+	BlockNum                               chain.BlockNum     `json:"block_num"`
+	BlockTime                              time.Time          `json:"block_time" time_format:"antelope-api" time_location:"antelope-api"`
+	ProducerBlockID                        *chain.Checksum256 `json:"producer_block_id"`
+	AccountRamDeltas                       []AccountRamDelta  `json:"account_ram_deltas"`
+	Except                                 *Exception         `json:"except"`
+	ErrorCode                              *chain.Uint64      `json:"error_code"`
+	ReturnValueHexData                     chain.Bytes        `json:"return_value_hex_data,omitempty"`
+	ReturnValueData                        interface{}        `json:"return_value_data,omitempty"`
+}
+
+type TransactionTrace struct {
+	ID              chain.Checksum256               `json:"id"`
+	BlockNum        chain.BlockNum                  `json:"block_num"`
+	BlockTime       time.Time                       `json:"block_time" time_format:"antelope-api" time_location:"antelope-api"`
+	ProducerBlockID *chain.Checksum256              `json:"producer_block_id"`
+	Receipt         *chain.TransactionReceiptHeader `json:"receipt"`
+	Elapsed         int64                           `json:"elapsed"`
+	NetUsage        uint64                          `json:"net_usage"`
+	Scheduled       bool                            `json:"scheduled"`
+	ActionTraces    []ActionTrace                   `json:"action_traces"`
+	AccountRamDelta *AccountRamDelta                `json:"account_ram_delta"`
+	Except          *Exception                      `json:"except"`
+	ErrorCode       *chain.Uint64                   `json:"error_code"`
+}
+
+// Returns the raw action. HexData is used if present, otherwise Data must be a hex string.
+func (a TraceAction) Action() (*chain.Action, error) {
+	data := a.HexData
+	if data == nil {
+		s, ok := a.Data.(string)
+		if !ok {
+			return nil, errors.New("action has no raw data")
+		}
+		var err error
+		data, err = hex.DecodeString(s)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return chain.NewAction(a.Account, a.Name, a.Authorization, data), nil
+}
+
+func (e Exception) Error() string {
+	return fmt.Sprintf("%s: %s", e.Name, e.Message)
+}
+
+// Console output of all actions in execution order.
+func (t TransactionTrace) Console() string {
+	var sb strings.Builder
+	for _, at := range t.ActionTraces {
+		sb.WriteString(at.Console)
+	}
+	return sb.String()
+}
+
+// json.Marshaler conformance
+
+func (as AuthSequence) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]interface{}{as.Account, as.Sequence})
+}
+
+// json.Unmarshaler conformance
+
+func (as *AuthSequence) UnmarshalJSON(b []byte) error {
+	var v []jsoniter.RawMessage
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	if len(v) != 2 {
+		return fmt.Errorf("invalid auth sequence: %s", string(b))
+	}
+	if err := json.Unmarshal(v[0], &as.Account); err != nil {
+		return err
+	}
+	return json.Unmarshal(v[1], &as.Sequence)
+}
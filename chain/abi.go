@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"sync"
 
 	"github.com/shufflingpixels/antelope-go/abi"
 )
@@ -148,6 +149,54 @@ func (a Abi) Encode(w io.Writer, name string, v interface{}) error {
 	return a.encodeType(enc, t, v)
 }
 
+// ResolvedAbi caches the resolved type graph of an abi, so types are only
+// resolved once instead of on every Encode/Decode call. Safe for concurrent use.
+type ResolvedAbi struct {
+	Abi
+	mu  sync.Mutex
+	res resolver
+}
+
+func NewResolvedAbi(abi Abi) *ResolvedAbi {
+	ra := &ResolvedAbi{Abi: abi}
+	ra.res = resolver{&ra.Abi, make(map[string]*resolvedType)}
+	return ra
+}
+
+func (ra *ResolvedAbi) resolve(name string) *resolvedType {
+	ra.mu.Lock()
+	defer ra.mu.Unlock()
+	return ra.res.resolve(name)
+}
+
+func (ra *ResolvedAbi) DecodeAction(r io.Reader, name string) (interface{}, error) {
+	act := ra.GetAction(name)
+	if act == nil {
+		return nil, fmt.Errorf("unknown action %v", name)
+	}
+	return ra.Decode(r, act.Type)
+}
+
+func (ra *ResolvedAbi) EncodeAction(w io.Writer, name string, v interface{}) error {
+	act := ra.GetAction(name)
+	if act == nil {
+		return fmt.Errorf("unknown action %v", name)
+	}
+	return ra.Encode(w, act.Type, v)
+}
+
+func (ra *ResolvedAbi) Decode(r io.Reader, name string) (interface{}, error) {
+	t := ra.resolve(name)
+	var rv interface{}
+	err := ra.decodeType(NewDecoder(r), t, &rv)
+	return rv, err
+}
+
+func (ra *ResolvedAbi) Encode(w io.Writer, name string, v interface{}) error {
+	t := ra.resolve(name)
+	return ra.encodeType(NewEncoder(w), t, v)
+}
+
 func (a Abi) encodeType(enc *abi.Encoder, t *resolvedType, v interface{}) error {
 	var err error
 	exists := v != nil
@@ -3,6 +3,7 @@ package chain_test
 import (
 	"bytes"
 	"encoding/json"
+	"sync"
 	"testing"
 
 	"github.com/shufflingpixels/antelope-go/chain"
@@ -310,3 +311,30 @@ func TestAbiDecodeActionEmptyStruct(t *testing.T) {
 	_, err := tokenAbi.DecodeAction(bytes.NewBuffer([]byte{}), "noop")
 	assert.NoError(t, err)
 }
+
+func TestResolvedAbi(t *testing.T) {
+	ra := chain.NewResolvedAbi(*tokenAbi)
+	expected, err := tokenAbi.DecodeAction(bytes.NewReader(transferData), "bigtransfer")
+	assert.NoError(t, err)
+
+	// concurrent use resolves the types once.
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rv, err := ra.DecodeAction(bytes.NewReader(transferData), "bigtransfer")
+			assert.NoError(t, err)
+			assert.Equal(t, expected, rv)
+		}()
+	}
+	wg.Wait()
+
+	buf := bytes.NewBuffer(nil)
+	err = ra.EncodeAction(buf, "bigtransfer", expected)
+	assert.NoError(t, err)
+	assert.Equal(t, transferData, buf.Bytes())
+
+	_, err = ra.DecodeAction(bytes.NewReader(transferData), "not_found")
+	assert.HasError(t, &err)
+}
@@ -0,0 +1,44 @@
+package chain_test
+
+import (
+	"testing"
+
+	"github.com/shufflingpixels/antelope-go/chain"
+	"github.com/shufflingpixels/antelope-go/internal/assert"
+)
+
+func TestAuthority(t *testing.T) {
+	auth := chain.Authority{
+		Threshold: 2,
+		Keys: []chain.KeyWeight{
+			{Key: chain.MustNewPublicKeyFromString("PUB_K1_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5BoDq63"), Weight: 1},
+		},
+		Accounts: []chain.PermissionLevelWeight{
+			{Permission: chain.PermissionLevel{Actor: chain.N("foo"), Permission: chain.N("active")}, Weight: 1},
+		},
+		Waits: []chain.WaitWeight{
+			{WaitSec: 3600, Weight: 1},
+		},
+	}
+	assert.ABICoding(t, auth, []byte{
+		0x02, 0x00, 0x00, 0x00, 0x01, 0x00, 0x02, 0xc0, 0xde, 0xd2, 0xbc, 0x1f, 0x13, 0x05, 0xfb, 0x0f,
+		0xaa, 0xc5, 0xe6, 0xc0, 0x3e, 0xe3, 0xa1, 0x92, 0x42, 0x34, 0x98, 0x54, 0x27, 0xb6, 0x16, 0x7c,
+		0xa5, 0x69, 0xd1, 0x3d, 0xf4, 0x35, 0xcf, 0x01, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x28, 0x5d, 0x00, 0x00, 0x00, 0x00, 0xa8, 0xed, 0x32, 0x32, 0x01, 0x00, 0x01, 0x10, 0x0e, 0x00,
+		0x00, 0x01, 0x00,
+	})
+	assert.JSONCoding(t, auth, `
+		{
+			"threshold": 2,
+			"keys": [
+				{"key": "PUB_K1_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5BoDq63", "weight": 1}
+			],
+			"accounts": [
+				{"permission": {"actor": "foo", "permission": "active"}, "weight": 1}
+			],
+			"waits": [
+				{"wait_sec": 3600, "weight": 1}
+			]
+		}
+	`)
+}
@@ -1,6 +1,11 @@
 package chain
 
-import "github.com/shufflingpixels/antelope-go/abi"
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/shufflingpixels/antelope-go/abi"
+)
 
 type CompressionType uint8
 
@@ -9,6 +14,17 @@ const (
 	CompressionZlib
 )
 
+func NewCompressionTypeFromString(s string) (CompressionType, error) {
+	switch s {
+	case "none":
+		return CompressionNone, nil
+	case "zlib":
+		return CompressionZlib, nil
+	default:
+		return 0, fmt.Errorf("unknown compression type: %s", s)
+	}
+}
+
 func (c CompressionType) String() string {
 	switch c {
 	case CompressionNone:
@@ -29,9 +45,39 @@ func (b CompressionType) MarshalABI(e *abi.Encoder) error {
 // abi.Unmarshaler conformance
 
 func (b *CompressionType) UnmarshalABI(d *abi.Decoder) error {
-	var err error
-	if v, err := d.ReadByte(); err == nil {
+	v, err := d.ReadByte()
+	if err == nil {
 		*b = CompressionType(v)
 	}
 	return err
 }
+
+// json.Marshaler conformance
+
+func (c CompressionType) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(c.String())), nil
+}
+
+// json.Unmarshaler conformance
+
+// nodeos uses the string representation, but older versions
+// and some tools emit the numeric value so accept both.
+func (c *CompressionType) UnmarshalJSON(b []byte) error {
+	s := string(b)
+	if len(s) > 1 && s[0] == '"' && s[len(s)-1] == '"' {
+		new, err := NewCompressionTypeFromString(s[1 : len(s)-1])
+		if err == nil {
+			*c = new
+		}
+		return err
+	}
+	v, err := strconv.ParseUint(s, 10, 8)
+	if err != nil {
+		return err
+	}
+	if CompressionType(v) > CompressionZlib {
+		return fmt.Errorf("unknown compression type: %d", v)
+	}
+	*c = CompressionType(v)
+	return nil
+}
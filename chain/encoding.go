@@ -37,12 +37,16 @@ func chainDecoder(dec *abi.Decoder, v interface{}) (done bool, err error) {
 		err = v.UnmarshalABI(dec)
 	case *Name:
 		err = v.UnmarshalABI(dec)
+	case *PackedTransaction:
+		err = v.UnmarshalABI(dec)
 	case *PermissionLevel:
 		err = v.UnmarshalABI(dec)
 	case *PublicKey:
 		err = v.UnmarshalABI(dec)
 	case *Signature:
 		err = v.UnmarshalABI(dec)
+	case *SignedTransaction:
+		err = v.UnmarshalABI(dec)
 	case *Symbol:
 		err = v.UnmarshalABI(dec)
 	case *SymbolCode:
@@ -94,12 +98,16 @@ func chainEncoder(enc *abi.Encoder, v interface{}) (done bool, err error) {
 		err = v.MarshalABI(enc)
 	case Name:
 		err = v.MarshalABI(enc)
+	case PackedTransaction:
+		err = v.MarshalABI(enc)
 	case PermissionLevel:
 		err = v.MarshalABI(enc)
 	case PublicKey:
 		err = v.MarshalABI(enc)
 	case Signature:
 		err = v.MarshalABI(enc)
+	case SignedTransaction:
+		err = v.MarshalABI(enc)
 	case Symbol:
 		err = v.MarshalABI(enc)
 	case SymbolCode:
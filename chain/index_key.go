@@ -0,0 +1,64 @@
+package chain
+
+import (
+	"encoding/hex"
+	"strconv"
+)
+
+// IndexKey is a table index key in the format nodeos expects for
+// lower_bound and upper_bound in get_table_rows, together with the key_type.
+type IndexKey struct {
+	Type  string
+	Value string
+}
+
+func NewNameIndexKey(n Name) IndexKey {
+	return IndexKey{Type: "name", Value: n.String()}
+}
+
+func NewUint64IndexKey(v uint64) IndexKey {
+	return IndexKey{Type: "i64", Value: strconv.FormatUint(v, 10)}
+}
+
+func NewUint128IndexKey(v Uint128) IndexKey {
+	return IndexKey{Type: "i128", Value: v.String()}
+}
+
+// Key for checksum256 indexes, nodeos converts the digest to the word order used by the index.
+func NewChecksum256IndexKey(c Checksum256) IndexKey {
+	return IndexKey{Type: "sha256", Value: c.String()}
+}
+
+// Key for checksum256 indexes queried as a 256 bit integer (key_type "i256").
+//
+// nodeos reads i256 keys as a little endian integer, so the
+// checksum bytes need to be reversed to match the stored checksum.
+func NewI256IndexKey(c Checksum256) IndexKey {
+	b := make([]byte, len(c))
+	for i := range c {
+		b[len(c)-1-i] = c[i]
+	}
+	return IndexKey{Type: "i256", Value: "0x" + hex.EncodeToString(b)}
+}
+
+func NewChecksum160IndexKey(c Checksum160) IndexKey {
+	return IndexKey{Type: "ripemd160", Value: c.String()}
+}
+
+func NewFloat64IndexKey(f float64) IndexKey {
+	return IndexKey{Type: "float64", Value: strconv.FormatFloat(f, 'g', -1, 64)}
+}
+
+// Key for indexes using the raw value of a symbol (precision and code).
+func NewSymbolIndexKey(s Symbol) IndexKey {
+	return NewUint64IndexKey(uint64(s))
+}
+
+// Key for indexes using the raw value of a symbol code, eosio.token's accounts and stat tables for example.
+func NewSymbolCodeIndexKey(sc SymbolCode) IndexKey {
+	return NewUint64IndexKey(uint64(sc))
+}
+
+func (k IndexKey) String() string {
+	return k.Value
+}
@@ -0,0 +1,47 @@
+package chain_test
+
+import (
+	"testing"
+
+	"github.com/shufflingpixels/antelope-go/chain"
+	"github.com/shufflingpixels/antelope-go/internal/assert"
+)
+
+func TestIndexKey(t *testing.T) {
+	var c256 chain.Checksum256
+	err := c256.UnmarshalText([]byte("f58262c8005bb64b8f99ec6083faf050c502d099d9929ae37ffed2fe1bb954fb"))
+	assert.NoError(t, err)
+
+	var c160 chain.Checksum160
+	err = c160.UnmarshalText([]byte("2cb3b9fcb36f5c5bb4a53aea0c98a6ea1dc0e14a"))
+	assert.NoError(t, err)
+
+	u128, err := chain.NewUint128FromString("340282366920938463463374607431768211455")
+	assert.NoError(t, err)
+
+	sym, err := chain.NewSymbolFromString("4,EOS")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		key   chain.IndexKey
+		typ   string
+		value string
+	}{
+		{chain.NewNameIndexKey(chain.N("eosio.token")), "name", "eosio.token"},
+		{chain.NewUint64IndexKey(1234), "i64", "1234"},
+		{chain.NewUint128IndexKey(u128), "i128", "340282366920938463463374607431768211455"},
+		{chain.NewChecksum256IndexKey(c256), "sha256", "f58262c8005bb64b8f99ec6083faf050c502d099d9929ae37ffed2fe1bb954fb"},
+		{chain.NewI256IndexKey(c256), "i256", "0xfb54b91bfed2fe7fe39a92d999d002c550f0fa8360ec998f4bb65b00c86282f5"},
+		{chain.NewChecksum160IndexKey(c160), "ripemd160", "2cb3b9fcb36f5c5bb4a53aea0c98a6ea1dc0e14a"},
+		{chain.NewFloat64IndexKey(1.5), "float64", "1.5"},
+		{chain.NewFloat64IndexKey(-0.001), "float64", "-0.001"},
+		{chain.NewSymbolIndexKey(sym), "i64", "1397703940"},
+		{chain.NewSymbolCodeIndexKey(sym.Code()), "i64", "5459781"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.key.Type, test.typ)
+		assert.Equal(t, test.key.Value, test.value)
+		assert.Equal(t, test.key.String(), test.value)
+	}
+}
@@ -0,0 +1,105 @@
+package chain
+
+import (
+	"errors"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+)
+
+// secp256k1 helpers shared by the K1 key and signature types.
+
+var (
+	ErrInvalidK1Key       = errors.New("invalid k1 key")
+	ErrInvalidK1Signature = errors.New("invalid k1 signature")
+)
+
+func k1PrivateKey(data []byte) (*secp256k1.PrivateKey, error) {
+	if len(data) != 32 {
+		return nil, ErrInvalidK1Key
+	}
+	var k secp256k1.ModNScalar
+	if overflow := k.SetByteSlice(data); overflow || k.IsZero() {
+		return nil, ErrInvalidK1Key
+	}
+	return secp256k1.NewPrivateKey(&k), nil
+}
+
+// Returns true if the compact signature is accepted by nodeos,
+// mirrors is_canonical() in fc.
+func k1IsCanonical(c []byte) bool {
+	return c[1]&0x80 == 0 &&
+		!(c[1] == 0 && c[2]&0x80 == 0) &&
+		c[33]&0x80 == 0 &&
+		!(c[33] == 0 && c[34]&0x80 == 0)
+}
+
+// Sign the digest, returns a 65 byte compact signature <recovery code><r><s>.
+//
+// Nodeos only accepts canonical signatures, so just like fc we keep
+// generating RFC6979 nonces with an increasing counter until we find one.
+func k1Sign(key *secp256k1.PrivateKey, digest Checksum256) []byte {
+	var kb [32]byte
+	key.Key.PutBytes(&kb)
+
+	var e secp256k1.ModNScalar
+	e.SetBytes((*[32]byte)(&digest))
+
+	sig := make([]byte, 65)
+	for counter := uint32(1); ; counter++ {
+		k := secp256k1.NonceRFC6979(kb[:], digest[:], nil, nil, counter)
+
+		// R = kG
+		var R secp256k1.JacobianPoint
+		secp256k1.ScalarBaseMultNonConst(k, &R)
+		R.ToAffine()
+
+		// r = R.x mod N
+		var r secp256k1.ModNScalar
+		recid := byte(0)
+		if overflow := r.SetBytes(R.X.Bytes()); overflow == 1 {
+			recid |= 0x02
+		}
+		if R.Y.IsOdd() {
+			recid |= 0x01
+		}
+		if r.IsZero() {
+			continue
+		}
+
+		// s = k^-1(e + dr) mod N
+		kinv := new(secp256k1.ModNScalar).InverseValNonConst(k)
+		s := new(secp256k1.ModNScalar).Mul2(&key.Key, &r).Add(&e).Mul(kinv)
+		if s.IsZero() {
+			continue
+		}
+		if s.IsOverHalfOrder() {
+			s.Negate()
+			recid ^= 0x01
+		}
+
+		sig[0] = compactSigRecoveryOffset + recid
+		r.PutBytesUnchecked(sig[1:33])
+		s.PutBytesUnchecked(sig[33:65])
+		if k1IsCanonical(sig) {
+			return sig
+		}
+	}
+}
+
+// Recover the public key from a 65 byte compact signature <recovery code><r><s>.
+func k1Recover(sig []byte, digest Checksum256) (*secp256k1.PublicKey, error) {
+	if len(sig) != 65 {
+		return nil, ErrInvalidK1Signature
+	}
+	// fc only looks at the two lowest bits of the recovery code (recovery id)
+	// so normalize it to what the secp256k1 library expects.
+	c := make([]byte, 65)
+	copy(c, sig)
+	c[0] = compactSigRecoveryOffset + (sig[0]-27)&0x03
+	pub, _, err := ecdsa.RecoverCompact(c, digest[:])
+	if err != nil {
+		return nil, ErrInvalidK1Signature
+	}
+	return pub, nil
+}
@@ -20,3 +20,11 @@ func (t KeyType) String() string {
 		return "XX"
 	}
 }
+
+// Type name used in key and signature strings, nodeos calls P1 keys "R1".
+func (t KeyType) stringPrefix() string {
+	if t == P1 {
+		return "R1"
+	}
+	return t.String()
+}
@@ -26,6 +26,12 @@ type Float128 struct {
 // uint64 alias that encodes to string for values above 32bit instead of scientific notation in JSON
 type Uint64 uint64
 
+// int64 alias that encodes to string for values outside 32bit range in JSON, same as nodeos
+type Int64 int64
+
+// float64 alias that accepts both numbers and strings in JSON, nodeos encodes doubles as strings
+type Float64 float64
+
 // Type representing a block number, EOSIO chains are only expected to live for 68 years, sorry kids!
 type BlockNum uint32
 
@@ -144,6 +150,14 @@ func (u64 Uint64) MarshalABI(e *abi.Encoder) error {
 	return e.WriteUint64(uint64(u64))
 }
 
+func (i64 Int64) MarshalABI(e *abi.Encoder) error {
+	return e.WriteInt64(int64(i64))
+}
+
+func (f64 Float64) MarshalABI(e *abi.Encoder) error {
+	return e.WriteFloat64(float64(f64))
+}
+
 func (bn BlockNum) MarshalABI(e *abi.Encoder) error {
 	return e.WriteUint32(uint32(bn))
 }
@@ -182,6 +196,22 @@ func (u64 *Uint64) UnmarshalABI(d *abi.Decoder) error {
 	return err
 }
 
+func (i64 *Int64) UnmarshalABI(d *abi.Decoder) error {
+	v, err := d.ReadInt64()
+	if err == nil {
+		*i64 = Int64(v)
+	}
+	return err
+}
+
+func (f64 *Float64) UnmarshalABI(d *abi.Decoder) error {
+	v, err := d.ReadFloat64()
+	if err == nil {
+		*f64 = Float64(v)
+	}
+	return err
+}
+
 func (bn *BlockNum) UnmarshalABI(d *abi.Decoder) error {
 	v, err := d.ReadUint32()
 	if err == nil {
@@ -232,6 +262,18 @@ func (u64 Uint64) MarshalJSON() ([]byte, error) {
 	return writeUintJSON(uint64(u64)), nil
 }
 
+func (i64 Int64) MarshalJSON() ([]byte, error) {
+	s := strconv.FormatInt(int64(i64), 10)
+	if i64 > math.MaxUint32 || i64 < -math.MaxUint32 {
+		s = `"` + s + `"`
+	}
+	return []byte(s), nil
+}
+
+func (f64 Float64) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + strconv.FormatFloat(float64(f64), 'f', -1, 64) + `"`), nil
+}
+
 func (bn BlockNum) MarshalJSON() ([]byte, error) {
 	return writeUintJSON(uint64(bn)), nil
 }
@@ -246,6 +288,22 @@ func (u64 *Uint64) UnmarshalJSON(b []byte) error {
 	return err
 }
 
+func (i64 *Int64) UnmarshalJSON(b []byte) error {
+	v, err := strconv.ParseInt(unquoteJSON(b), 10, 64)
+	if err == nil {
+		*i64 = Int64(v)
+	}
+	return err
+}
+
+func (f64 *Float64) UnmarshalJSON(b []byte) error {
+	v, err := strconv.ParseFloat(unquoteJSON(b), 64)
+	if err == nil {
+		*f64 = Float64(v)
+	}
+	return err
+}
+
 func (bn *BlockNum) UnmarshalJSON(b []byte) error {
 	v, err := readUintJSON(b)
 	if v > math.MaxUint32 {
@@ -259,12 +317,16 @@ func (bn *BlockNum) UnmarshalJSON(b []byte) error {
 
 // json helpers
 
-func readUintJSON(b []byte) (uint64, error) {
+func unquoteJSON(b []byte) string {
 	s := string(b)
 	if len(s) > 1 && s[0] == '"' && s[len(s)-1] == '"' {
 		s = s[1 : len(s)-1]
 	}
-	return strconv.ParseUint(s, 10, 64)
+	return s
+}
+
+func readUintJSON(b []byte) (uint64, error) {
+	return strconv.ParseUint(unquoteJSON(b), 10, 64)
 }
 
 func writeUintJSON(v uint64) []byte {
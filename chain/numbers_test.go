@@ -1,6 +1,7 @@
 package chain_test
 
 import (
+	"encoding/json"
 	"math"
 	"math/big"
 	"testing"
@@ -115,6 +116,29 @@ func TestUint64(t *testing.T) {
 	assert.JSONCoding(t, chain.Uint64(4294967296), `"4294967296"`)
 }
 
+func TestInt64(t *testing.T) {
+	assert.JSONCoding(t, chain.Int64(0), `0`)
+	assert.JSONCoding(t, chain.Int64(-1), `-1`)
+	assert.JSONCoding(t, chain.Int64(4294967295), `4294967295`)
+	assert.JSONCoding(t, chain.Int64(4294967296), `"4294967296"`)
+	assert.JSONCoding(t, chain.Int64(-4294967296), `"-4294967296"`)
+	assert.ABICoding(t, chain.Int64(-2), []byte{0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff})
+}
+
+func TestFloat64(t *testing.T) {
+	assert.JSONCoding(t, chain.Float64(0), `"0"`)
+	assert.JSONCoding(t, chain.Float64(1234.5678), `"1234.5678"`)
+	assert.ABICoding(t, chain.Float64(1), []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f})
+
+	var f chain.Float64
+	err := json.Unmarshal([]byte(`"4190649434567216.50000000000000000"`), &f)
+	assert.NoError(t, err)
+	assert.Equal(t, f, chain.Float64(4190649434567216.5))
+	err = json.Unmarshal([]byte(`12.5`), &f)
+	assert.NoError(t, err)
+	assert.Equal(t, f, chain.Float64(12.5))
+}
+
 func TestFloat128(t *testing.T) {
 	f1 := chain.Float128{
 		Data: [16]byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
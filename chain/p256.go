@@ -0,0 +1,146 @@
+package chain
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"math/big"
+)
+
+// NIST P-256 (secp256r1) helpers shared by the P1 key and signature types.
+
+var (
+	ErrInvalidP1Key       = errors.New("invalid p1 key")
+	ErrInvalidP1Signature = errors.New("invalid p1 signature")
+)
+
+// Parse a 33 byte compressed point.
+func p256PublicKey(data []byte) (*ecdsa.PublicKey, error) {
+	if len(data) != 33 {
+		return nil, ErrInvalidP1Key
+	}
+	x, y := elliptic.UnmarshalCompressed(elliptic.P256(), data)
+	if x == nil {
+		return nil, ErrInvalidP1Key
+	}
+	return &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, nil
+}
+
+func p256PrivateKey(data []byte) (*ecdsa.PrivateKey, error) {
+	if len(data) != 32 {
+		return nil, ErrInvalidP1Key
+	}
+	c := elliptic.P256()
+	d := new(big.Int).SetBytes(data)
+	if d.Sign() == 0 || d.Cmp(c.Params().N) >= 0 {
+		return nil, ErrInvalidP1Key
+	}
+	x, y := c.ScalarBaseMult(data)
+	return &ecdsa.PrivateKey{
+		PublicKey: ecdsa.PublicKey{Curve: c, X: x, Y: y},
+		D:         d,
+	}, nil
+}
+
+func p256GenerateKey() ([]byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return key.D.FillBytes(make([]byte, 32)), nil
+}
+
+func p256Compress(key *ecdsa.PublicKey) []byte {
+	return elliptic.MarshalCompressed(key.Curve, key.X, key.Y)
+}
+
+// Sign the digest, returns a 65 byte compact signature <recovery code><r><s>
+// in the same layout as K1 signatures.
+func p256Sign(key *ecdsa.PrivateKey, digest Checksum256) ([]byte, error) {
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	if err != nil {
+		return nil, err
+	}
+
+	// nodeos only accepts signatures with low s
+	n := key.Params().N
+	if s.Cmp(new(big.Int).Rsh(n, 1)) > 0 {
+		s.Sub(n, s)
+	}
+
+	sig := make([]byte, 65)
+	r.FillBytes(sig[1:33])
+	s.FillBytes(sig[33:65])
+
+	// find the recovery id that gives back our public key.
+	pub := p256Compress(&key.PublicKey)
+	for recid := byte(0); recid < 4; recid++ {
+		sig[0] = compactSigRecoveryOffset + recid
+		rec, err := p256Recover(sig, digest)
+		if err == nil && bytes.Equal(p256Compress(rec), pub) {
+			return sig, nil
+		}
+	}
+	return nil, ErrInvalidP1Signature
+}
+
+// Recover the public key from a 65 byte compact signature <recovery code><r><s>.
+//
+// See SEC 1 v2 section 4.1.6
+func p256Recover(sig []byte, digest Checksum256) (*ecdsa.PublicKey, error) {
+	if len(sig) != 65 {
+		return nil, ErrInvalidP1Signature
+	}
+	c := elliptic.P256()
+	params := c.Params()
+	recid := (sig[0] - 27) & 0x03
+	r := new(big.Int).SetBytes(sig[1:33])
+	s := new(big.Int).SetBytes(sig[33:65])
+	if r.Sign() == 0 || r.Cmp(params.N) >= 0 || s.Sign() == 0 || s.Cmp(params.N) >= 0 {
+		return nil, ErrInvalidP1Signature
+	}
+
+	// R.x = r + j*n
+	x := new(big.Int).Set(r)
+	if recid&0x02 != 0 {
+		x.Add(x, params.N)
+		if x.Cmp(params.P) >= 0 {
+			return nil, ErrInvalidP1Signature
+		}
+	}
+	compressed := make([]byte, 33)
+	compressed[0] = 0x02 | recid&0x01
+	x.FillBytes(compressed[1:])
+	Rx, Ry := elliptic.UnmarshalCompressed(c, compressed)
+	if Rx == nil {
+		return nil, ErrInvalidP1Signature
+	}
+
+	// Q = r^-1(sR - eG)
+	sRx, sRy := c.ScalarMult(Rx, Ry, s.Bytes())
+	e := new(big.Int).SetBytes(digest[:])
+	e.Mod(e, params.N)
+	Qx, Qy := sRx, sRy
+	if e.Sign() != 0 {
+		eGx, eGy := c.ScalarBaseMult(e.Bytes())
+		eGy.Sub(params.P, eGy) // negate
+		Qx, Qy = c.Add(sRx, sRy, eGx, eGy)
+	}
+	rinv := new(big.Int).ModInverse(r, params.N)
+	Qx, Qy = c.ScalarMult(Qx, Qy, rinv.Bytes())
+	if Qx.Sign() == 0 && Qy.Sign() == 0 {
+		return nil, ErrInvalidP1Signature
+	}
+	return &ecdsa.PublicKey{Curve: c, X: Qx, Y: Qy}, nil
+}
+
+func p256Verify(key *ecdsa.PublicKey, sig []byte, digest Checksum256) bool {
+	if len(sig) != 65 {
+		return false
+	}
+	r := new(big.Int).SetBytes(sig[1:33])
+	s := new(big.Int).SetBytes(sig[33:65])
+	return ecdsa.Verify(key, digest[:], r, s)
+}
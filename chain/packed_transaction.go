@@ -1,11 +1,183 @@
 package chain
 
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+
+	"github.com/shufflingpixels/antelope-go/abi"
+)
+
+// Maximum size of decompressed data, same limit as nodeos.
+const maxUncompressedSize = 1024 * 1024
+
 // PackedTransaction represents a fully packed transaction, with
 // signatures, and all. They circulate like that on the P2P net, and
 // that's how they are stored.
 type PackedTransaction struct {
 	Signatures            []Signature     `json:"signatures"`
-	Compression           CompressionType `json:"compression"` // in C++, it's an enum, not sure how it Binary-marshals..
+	Compression           CompressionType `json:"compression"`
 	PackedContextFreeData Bytes           `json:"packed_context_free_data"`
 	PackedTransaction     Bytes           `json:"packed_trx"`
 }
+
+// Create a new packed transaction, the transaction and context free data
+// is compressed according to compression.
+func NewPackedTransaction(tx Transaction, sigs []Signature, cfd []Bytes, compression CompressionType) (*PackedTransaction, error) {
+	var err error
+	ptx := &PackedTransaction{
+		Signatures:            sigs,
+		Compression:           compression,
+		PackedContextFreeData: Bytes{},
+	}
+	if ptx.Signatures == nil {
+		ptx.Signatures = []Signature{}
+	}
+	ptx.PackedTransaction, err = compress(compression, tx.pack())
+	if err != nil {
+		return nil, err
+	}
+	if len(cfd) > 0 {
+		ptx.PackedContextFreeData, err = compress(compression, packContextFreeData(cfd))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ptx, nil
+}
+
+// Decompress and decode the transaction.
+func (ptx PackedTransaction) Unpack() (*Transaction, error) {
+	data, err := decompress(ptx.Compression, ptx.PackedTransaction)
+	if err != nil {
+		return nil, err
+	}
+	tx := &Transaction{}
+	err = tx.UnmarshalABI(NewDecoder(bytes.NewReader(data)))
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// Decompress and decode the context free data.
+func (ptx PackedTransaction) UnpackContextFreeData() ([]Bytes, error) {
+	if len(ptx.PackedContextFreeData) < 1 {
+		return []Bytes{}, nil
+	}
+	data, err := decompress(ptx.Compression, ptx.PackedContextFreeData)
+	if err != nil {
+		return nil, err
+	}
+	cfd := []Bytes{}
+	err = NewDecoder(bytes.NewReader(data)).Decode(&cfd)
+	if err != nil {
+		return nil, err
+	}
+	return cfd, nil
+}
+
+// Transaction id of the packed transaction.
+func (ptx PackedTransaction) ID() (Checksum256, error) {
+	tx, err := ptx.Unpack()
+	if err != nil {
+		return Checksum256{}, err
+	}
+	return tx.ID(), nil
+}
+
+// abi.Marshaler conformance
+
+func (ptx PackedTransaction) MarshalABI(e *abi.Encoder) error {
+	var err error
+	l := uint(len(ptx.Signatures))
+	err = e.WriteVaruint(l)
+	if err != nil {
+		return err
+	}
+	for i := uint(0); i < l; i++ {
+		err = ptx.Signatures[i].MarshalABI(e)
+		if err != nil {
+			return err
+		}
+	}
+	err = ptx.Compression.MarshalABI(e)
+	if err != nil {
+		return err
+	}
+	err = ptx.PackedContextFreeData.MarshalABI(e)
+	if err != nil {
+		return err
+	}
+	return ptx.PackedTransaction.MarshalABI(e)
+}
+
+// abi.Unmarshaler conformance
+
+func (ptx *PackedTransaction) UnmarshalABI(d *abi.Decoder) error {
+	l, err := d.ReadVaruint()
+	if err != nil {
+		return err
+	}
+	ptx.Signatures = make([]Signature, l)
+	for i := 0; i < int(l); i++ {
+		err = ptx.Signatures[i].UnmarshalABI(d)
+		if err != nil {
+			return err
+		}
+	}
+	err = ptx.Compression.UnmarshalABI(d)
+	if err != nil {
+		return err
+	}
+	err = ptx.PackedContextFreeData.UnmarshalABI(d)
+	if err != nil {
+		return err
+	}
+	return ptx.PackedTransaction.UnmarshalABI(d)
+}
+
+// compression helpers
+
+func compress(c CompressionType, data []byte) ([]byte, error) {
+	switch c {
+	case CompressionNone:
+		return data, nil
+	case CompressionZlib:
+		b := bytes.NewBuffer(nil)
+		w := zlib.NewWriter(b)
+		if _, err := w.Write(data); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		return b.Bytes(), nil
+	default:
+		return nil, fmt.Errorf("unknown compression type: %d", c)
+	}
+}
+
+func decompress(c CompressionType, data []byte) ([]byte, error) {
+	switch c {
+	case CompressionNone:
+		return data, nil
+	case CompressionZlib:
+		r, err := zlib.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		out, err := io.ReadAll(io.LimitReader(r, maxUncompressedSize+1))
+		if err != nil {
+			return nil, err
+		}
+		if len(out) > maxUncompressedSize {
+			return nil, fmt.Errorf("decompressed data exceeds %d bytes", maxUncompressedSize)
+		}
+		return out, nil
+	default:
+		return nil, fmt.Errorf("unknown compression type: %d", c)
+	}
+}
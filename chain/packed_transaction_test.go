@@ -0,0 +1,76 @@
+package chain_test
+
+import (
+	"testing"
+
+	"github.com/shufflingpixels/antelope-go/chain"
+	"github.com/shufflingpixels/antelope-go/internal/assert"
+)
+
+func TestCompressionType(t *testing.T) {
+	assert.ABICoding(t, chain.CompressionZlib, []byte{0x01})
+	assert.JSONCoding(t, chain.CompressionNone, `"none"`)
+	assert.JSONCoding(t, chain.CompressionZlib, `"zlib"`)
+
+	var c chain.CompressionType
+	assert.NoError(t, c.UnmarshalJSON([]byte("1")))
+	assert.Equal(t, c, chain.CompressionZlib)
+
+	err := c.UnmarshalJSON([]byte(`"gzip"`))
+	assert.HasError(t, &err)
+	err = c.UnmarshalJSON([]byte("2"))
+	assert.HasError(t, &err)
+}
+
+func TestPackedTransaction(t *testing.T) {
+	tx := decodeTransactionHex(t, "d20296490b0016000000212c370001000000000000285d000000000000ae39000000")
+
+	ptx, err := chain.NewPackedTransaction(tx, nil, nil, chain.CompressionNone)
+	assert.NoError(t, err)
+	assert.ABICoding(t, *ptx, []byte{
+		0x00, 0x00, 0x00, 0x22, 0xd2, 0x02, 0x96, 0x49, 0x0b, 0x00, 0x16, 0x00, 0x00, 0x00, 0x21, 0x2c,
+		0x37, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x28, 0x5d, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0xae, 0x39, 0x00, 0x00, 0x00,
+	})
+	assert.JSONCoding(t, *ptx, `
+		{
+			"signatures": [],
+			"compression": "none",
+			"packed_context_free_data": "",
+			"packed_trx": "d20296490b0016000000212c370001000000000000285d000000000000ae39000000"
+		}
+	`)
+}
+
+func TestPackedTransactionUnpack(t *testing.T) {
+	// eosdactokens transfer in block 6342084 on EOS mainnet.
+	tx := decodeTransactionHex(t, "a9d04d5b7dc43400f690000000000180a7823423933055000000572d3ccdcd01a0986aff4998886700000000a8ed323221a0986aff49988867100261f9519b8867881300000000000004454f53444143000000")
+	sig := chain.MustNewSignatureString("SIG_K1_KXsd17mt6qf8JAHvRiVLRH93tMoQrkC69qhoS2suG8N3YYF54LTVkSwnh4t4wscDJXPnSAdbJZpSfHjJjSurDmwGCAxvTs")
+	cfd := []chain.Bytes{{0xbe, 0xef}, {}}
+
+	for _, c := range []chain.CompressionType{chain.CompressionNone, chain.CompressionZlib} {
+		ptx, err := chain.NewPackedTransaction(tx, []chain.Signature{sig}, cfd, c)
+		assert.NoError(t, err)
+		assert.Equal(t, ptx.Compression, c)
+		assert.Equal(t, ptx.Signatures, []chain.Signature{sig})
+
+		unpacked, err := ptx.Unpack()
+		assert.NoError(t, err)
+		assert.Equal(t, unpacked.ID(), tx.ID())
+
+		unpackedCfd, err := ptx.UnpackContextFreeData()
+		assert.NoError(t, err)
+		assert.Equal(t, unpackedCfd, cfd)
+
+		id, err := ptx.ID()
+		assert.NoError(t, err)
+		assert.Equal(t, id.String(), "7074b6caaac4dfe1d19903a41b88a53b595e963bab02139a508785eba6e11ba5")
+	}
+
+	ptx := chain.PackedTransaction{
+		Compression:       chain.CompressionZlib,
+		PackedTransaction: chain.Bytes{0xde, 0xad, 0xbe, 0xef},
+	}
+	_, err := ptx.Unpack()
+	assert.HasError(t, &err)
+}
@@ -0,0 +1,168 @@
+package chain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/shufflingpixels/antelope-go/base58"
+)
+
+// version byte of legacy WIF encoded keys.
+const wifVersion = 0x80
+
+type PrivateKey struct {
+	Type KeyType
+	Data []byte
+}
+
+func NewPrivateKey(t KeyType, d []byte) *PrivateKey {
+	return &PrivateKey{
+		Type: t,
+		Data: d,
+	}
+}
+
+// Create new private key from string, accepts both "PVT_K1_..." and legacy WIF format.
+func NewPrivateKeyFromString(s string) (*PrivateKey, error) {
+	if len(s) < 7 {
+		return nil, errors.New("invalid private key string")
+	}
+	if s[0:4] == "PVT_" {
+		// new format
+		var t KeyType
+		switch s[4:6] {
+		case "K1":
+			t = K1
+		case "P1", "R1":
+			t = P1
+		default:
+			return nil, fmt.Errorf("unknown key type: %s", s[4:6])
+		}
+		d, err := base58.CheckDecodeEosio(s[7:], s[4:6])
+		if err != nil {
+			return nil, err
+		}
+		if len(d) != 32 {
+			return nil, errors.New("invalid private key length")
+		}
+		return &PrivateKey{
+			Type: t,
+			Data: d,
+		}, nil
+	}
+	// legacy format
+	d, err := base58.CheckDecode(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(d) != 33 || d[0] != wifVersion {
+		return nil, errors.New("invalid wif private key")
+	}
+	return &PrivateKey{
+		Type: K1,
+		Data: d[1:],
+	}, nil
+}
+
+// Generate a new random private key, only K1 and P1 keys are supported.
+func NewRandomPrivateKey(t KeyType) (*PrivateKey, error) {
+	var d []byte
+	switch t {
+	case K1:
+		key, err := secp256k1.GeneratePrivateKey()
+		if err != nil {
+			return nil, err
+		}
+		d = key.Serialize()
+	case P1:
+		var err error
+		d, err = p256GenerateKey()
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported key type: %s", t)
+	}
+	return NewPrivateKey(t, d), nil
+}
+
+func MustNewPrivateKeyFromString(s string) PrivateKey {
+	pk, err := NewPrivateKeyFromString(s)
+	if err != nil {
+		panic(err)
+	}
+	return *pk
+}
+
+func (pk *PrivateKey) String() string {
+	return "PVT_" + pk.Type.stringPrefix() + "_" + base58.CheckEncodeEosio(pk.Data, pk.Type.stringPrefix())
+}
+
+// panics if key type isn't k1
+func (pk *PrivateKey) LegacyString() string {
+	if pk.Type != K1 {
+		panic("only K1 keys can be converted to legacy format")
+	}
+	return base58.CheckEncode(append([]byte{wifVersion}, pk.Data...))
+}
+
+// Derive the public key.
+func (pk *PrivateKey) PublicKey() (*PublicKey, error) {
+	switch pk.Type {
+	case K1:
+		key, err := k1PrivateKey(pk.Data)
+		if err != nil {
+			return nil, err
+		}
+		return NewPublicKey(K1, key.PubKey().SerializeCompressed()), nil
+	case P1:
+		key, err := p256PrivateKey(pk.Data)
+		if err != nil {
+			return nil, err
+		}
+		return NewPublicKey(P1, p256Compress(&key.PublicKey)), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type: %s", pk.Type)
+	}
+}
+
+// Sign a digest, the signature is always canonical (low s).
+func (pk *PrivateKey) Sign(digest Checksum256) (*Signature, error) {
+	switch pk.Type {
+	case K1:
+		key, err := k1PrivateKey(pk.Data)
+		if err != nil {
+			return nil, err
+		}
+		return NewSignature(K1, k1Sign(key, digest)), nil
+	case P1:
+		key, err := p256PrivateKey(pk.Data)
+		if err != nil {
+			return nil, err
+		}
+		sig, err := p256Sign(key, digest)
+		if err != nil {
+			return nil, err
+		}
+		return NewSignature(P1, sig), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type: %s", pk.Type)
+	}
+}
+
+// encoding.TextMarshaler conformance
+
+func (pk PrivateKey) MarshalText() (text []byte, err error) {
+	return []byte(pk.String()), nil
+}
+
+// encoding.TextUnmarshaler conformance
+
+func (pk *PrivateKey) UnmarshalText(text []byte) error {
+	new, err := NewPrivateKeyFromString(string(text))
+	if err == nil {
+		*pk = *new
+	}
+	return err
+}
@@ -0,0 +1,118 @@
+package chain_test
+
+import (
+	"testing"
+
+	"github.com/shufflingpixels/antelope-go/chain"
+	"github.com/shufflingpixels/antelope-go/internal/assert"
+)
+
+func TestPrivateKey(t *testing.T) {
+	pk, err := chain.NewPrivateKeyFromString("5KQwrPbwdL6PhXujxW37FSSQZ1JiwsST4cqQzDeyXtP79zkvFD3")
+	assert.NoError(t, err)
+	assert.Equal(t, pk.Type, chain.K1)
+	assert.Equal(t, pk.String(), "PVT_K1_2bfGi9rYsXQSXXTvJbDAPhHLQUojjaNLomdm3cEJ1XTzMqUt3V")
+	assert.Equal(t, pk.LegacyString(), "5KQwrPbwdL6PhXujxW37FSSQZ1JiwsST4cqQzDeyXtP79zkvFD3")
+	assert.JSONCoding(t, pk, `"PVT_K1_2bfGi9rYsXQSXXTvJbDAPhHLQUojjaNLomdm3cEJ1XTzMqUt3V"`)
+
+	pub, err := pk.PublicKey()
+	assert.NoError(t, err)
+	assert.Equal(t, pub.String(), "PUB_K1_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5BoDq63")
+	assert.Equal(t, pub.LegacyString("EOS"), "EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV")
+
+	pk2, err := chain.NewPrivateKeyFromString("PVT_K1_2bfGi9rYsXQSXXTvJbDAPhHLQUojjaNLomdm3cEJ1XTzMqUt3V")
+	assert.NoError(t, err)
+	assert.Equal(t, pk2, pk)
+}
+
+func TestPrivateKeyFromStringInvalid(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"PVT_XX_2bfGi9rYsXQSXXTvJbDAPhHLQUojjaNLomdm3cEJ1XTzMqUt3V",
+		"PVT_K1_2bfGi9rYsXQSXXTvJbDAPhHLQUojjaNLomdm3cEJ1XTzMqUt3v",
+		"5KQwrPbwdL6PhXujxW37FSSQZ1JiwsST4cqQzDeyXtP79zkvFD4",
+	} {
+		_, err := chain.NewPrivateKeyFromString(s)
+		assert.HasError(t, &err)
+	}
+}
+
+func TestPrivateKeySign(t *testing.T) {
+	pk := chain.MustNewPrivateKeyFromString("5KQwrPbwdL6PhXujxW37FSSQZ1JiwsST4cqQzDeyXtP79zkvFD3")
+	pub, err := pk.PublicKey()
+	assert.NoError(t, err)
+
+	for _, msg := range []string{"hello world", "foo", "bar", "baz"} {
+		digest := chain.Checksum256Digest([]byte(msg))
+		sig, err := pk.Sign(digest)
+		assert.NoError(t, err)
+		assert.Equal(t, sig.Type, chain.K1)
+		assert.Equal(t, len(sig.Data), 65)
+
+		// canonical
+		assert.True(t, sig.Data[1]&0x80 == 0 && sig.Data[33]&0x80 == 0)
+
+		// deterministic
+		sig2, err := pk.Sign(digest)
+		assert.NoError(t, err)
+		assert.Equal(t, sig2, sig)
+
+		assert.True(t, pub.Verify(digest, *sig))
+	}
+}
+
+func TestPrivateKeyP1(t *testing.T) {
+	pk, err := chain.NewRandomPrivateKey(chain.P1)
+	assert.NoError(t, err)
+	assert.Equal(t, pk.Type, chain.P1)
+	assert.Equal(t, len(pk.Data), 32)
+	assert.Equal(t, pk.String()[:7], "PVT_R1_")
+
+	decoded, err := chain.NewPrivateKeyFromString(pk.String())
+	assert.NoError(t, err)
+	assert.Equal(t, decoded, pk)
+
+	pub, err := pk.PublicKey()
+	assert.NoError(t, err)
+	assert.Equal(t, pub.Type, chain.P1)
+	assert.Equal(t, len(pub.Data), 33)
+	assert.Equal(t, pub.String()[:7], "PUB_R1_")
+
+	decodedPub, err := chain.NewPublicKeyFromString(pub.String())
+	assert.NoError(t, err)
+	assert.Equal(t, decodedPub, pub)
+
+	for _, msg := range []string{"hello world", "foo", "bar", "baz"} {
+		digest := chain.Checksum256Digest([]byte(msg))
+		sig, err := pk.Sign(digest)
+		assert.NoError(t, err)
+		assert.Equal(t, sig.Type, chain.P1)
+		assert.Equal(t, len(sig.Data), 65)
+		assert.True(t, pub.Verify(digest, *sig))
+		assert.True(t, !pub.Verify(chain.Checksum256Digest([]byte("other")), *sig))
+
+		recovered, err := sig.RecoverPublicKey(digest)
+		assert.NoError(t, err)
+		assert.Equal(t, recovered, pub)
+
+		assert.Equal(t, sig.String()[:7], "SIG_R1_")
+		decodedSig, err := chain.NewSignatureString(sig.String())
+		assert.NoError(t, err)
+		assert.Equal(t, decodedSig, sig)
+	}
+}
+
+func TestPrivateKeyRandomK1(t *testing.T) {
+	pk, err := chain.NewRandomPrivateKey(chain.K1)
+	assert.NoError(t, err)
+	pub, err := pk.PublicKey()
+	assert.NoError(t, err)
+
+	digest := chain.Checksum256Digest([]byte("hello world"))
+	sig, err := pk.Sign(digest)
+	assert.NoError(t, err)
+	assert.True(t, pub.Verify(digest, *sig))
+
+	_, err = chain.NewRandomPrivateKey(chain.WA)
+	assert.HasError(t, &err)
+}
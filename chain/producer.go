@@ -1,5 +1,13 @@
 package chain
 
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/shufflingpixels/antelope-go/abi"
+)
+
 type ProducerKey struct {
 	AccountName     Name      `json:"producer_name"`
 	BlockSigningKey PublicKey `json:"block_signing_key"`
@@ -9,3 +17,87 @@ type ProducerSchedule struct {
 	Version   uint32        `json:"version"`
 	Producers []ProducerKey `json:"producers"`
 }
+
+type BlockSigningAuthorityV0 struct {
+	Threshold uint32      `json:"threshold"`
+	Keys      []KeyWeight `json:"keys"`
+}
+
+// Block signing authority variant, only v0 exists.
+type BlockSigningAuthority struct {
+	V0 *BlockSigningAuthorityV0
+}
+
+type ProducerAuthority struct {
+	AccountName Name                  `json:"producer_name"`
+	Authority   BlockSigningAuthority `json:"authority"`
+}
+
+// Producer schedule introduced by the WTMSIG_BLOCK_SIGNATURES protocol feature.
+type ProducerAuthoritySchedule struct {
+	Version   uint32              `json:"version"`
+	Producers []ProducerAuthority `json:"producers"`
+}
+
+// abi.Marshaler conformance
+
+func (a BlockSigningAuthority) MarshalABI(e *abi.Encoder) error {
+	if a.V0 != nil {
+		if err := e.WriteByte(0x00); err != nil {
+			return err
+		}
+		return e.Encode(*a.V0)
+	}
+	return errors.New("empty block signing authority")
+}
+
+// abi.Unmarshaler conformance
+
+func (a *BlockSigningAuthority) UnmarshalABI(d *abi.Decoder) error {
+	typ, err := d.ReadByte()
+	if err != nil {
+		return err
+	}
+
+	switch typ {
+	case 0x0:
+		a.V0 = &BlockSigningAuthorityV0{}
+		return d.Decode(a.V0)
+	default:
+		return fmt.Errorf("invalid variant type %d", typ)
+	}
+}
+
+// json.Marshaler conformance
+
+func (a BlockSigningAuthority) MarshalJSON() ([]byte, error) {
+	if a.V0 != nil {
+		return json.Marshal([]interface{}{"block_signing_authority_v0", a.V0})
+	}
+	return nil, errors.New("empty block signing authority")
+}
+
+// json.Unmarshaler conformance
+
+// nodeos encodes the variant as [index, value] or [type name, value].
+func (a *BlockSigningAuthority) UnmarshalJSON(b []byte) error {
+	var variant []json.RawMessage
+	if err := json.Unmarshal(b, &variant); err != nil {
+		return err
+	}
+	if len(variant) != 2 {
+		return errors.New("invalid block signing authority variant")
+	}
+
+	var typ interface{}
+	if err := json.Unmarshal(variant[0], &typ); err != nil {
+		return err
+	}
+	switch typ {
+	case float64(0), "block_signing_authority_v0":
+		a.V0 = &BlockSigningAuthorityV0{}
+		return json.Unmarshal(variant[1], a.V0)
+	default:
+		return fmt.Errorf("invalid variant type %v", typ)
+	}
+}
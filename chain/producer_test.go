@@ -0,0 +1,62 @@
+package chain_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/shufflingpixels/antelope-go/chain"
+	"github.com/shufflingpixels/antelope-go/internal/assert"
+)
+
+func TestProducerAuthoritySchedule(t *testing.T) {
+	schedule := chain.ProducerAuthoritySchedule{
+		Version: 2,
+		Producers: []chain.ProducerAuthority{
+			{
+				AccountName: chain.N("foo"),
+				Authority: chain.BlockSigningAuthority{
+					V0: &chain.BlockSigningAuthorityV0{
+						Threshold: 1,
+						Keys: []chain.KeyWeight{
+							{Key: chain.MustNewPublicKeyFromString("PUB_K1_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5BoDq63"), Weight: 1},
+						},
+					},
+				},
+			},
+		},
+	}
+	assert.ABICoding(t, schedule, []byte{
+		0x02, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x28, 0x5d, 0x00, 0x01, 0x00,
+		0x00, 0x00, 0x01, 0x00, 0x02, 0xc0, 0xde, 0xd2, 0xbc, 0x1f, 0x13, 0x05, 0xfb, 0x0f, 0xaa, 0xc5,
+		0xe6, 0xc0, 0x3e, 0xe3, 0xa1, 0x92, 0x42, 0x34, 0x98, 0x54, 0x27, 0xb6, 0x16, 0x7c, 0xa5, 0x69,
+		0xd1, 0x3d, 0xf4, 0x35, 0xcf, 0x01, 0x00,
+	})
+	assert.JSONCoding(t, schedule, `
+		{
+			"version": 2,
+			"producers": [
+				{
+					"producer_name": "foo",
+					"authority": [
+						"block_signing_authority_v0",
+						{
+							"threshold": 1,
+							"keys": [
+								{"key": "PUB_K1_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5BoDq63", "weight": 1}
+							]
+						}
+					]
+				}
+			]
+		}
+	`)
+
+	// nodeos uses the variant index.
+	var auth chain.BlockSigningAuthority
+	err := json.Unmarshal([]byte(`[0, {"threshold": 1, "keys": [{"key": "PUB_K1_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5BoDq63", "weight": 1}]}]`), &auth)
+	assert.NoError(t, err)
+	assert.Equal(t, auth, schedule.Producers[0].Authority)
+
+	err = json.Unmarshal([]byte(`[1, {}]`), &auth)
+	assert.HasError(t, &err)
+}
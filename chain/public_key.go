@@ -1,6 +1,7 @@
 package chain
 
 import (
+	"bytes"
 	"encoding/binary"
 	"errors"
 	"fmt"
@@ -31,14 +32,14 @@ func NewPublicKeyFromString(s string) (*PublicKey, error) {
 		switch s[4:6] {
 		case "K1":
 			t = K1
-		case "P1":
+		case "P1", "R1":
 			t = P1
 		case "WA":
 			t = WA
 		default:
 			return nil, fmt.Errorf("unknown key type: %s", s[4:6])
 		}
-		d, err := base58.CheckDecodeEosio(s[7:], t.String())
+		d, err := base58.CheckDecodeEosio(s[7:], s[4:6])
 		return &PublicKey{
 			Type: t,
 			Data: d,
@@ -61,7 +62,7 @@ func MustNewPublicKeyFromString(s string) PublicKey {
 }
 
 func (pk *PublicKey) String() string {
-	return "PUB_" + pk.Type.String() + "_" + base58.CheckEncodeEosio(pk.Data, pk.Type.String())
+	return "PUB_" + pk.Type.stringPrefix() + "_" + base58.CheckEncodeEosio(pk.Data, pk.Type.stringPrefix())
 }
 
 // panics if key type isn't k1
@@ -72,6 +73,25 @@ func (pk *PublicKey) LegacyString(prefix string) string {
 	return prefix + base58.CheckEncodeEosio(pk.Data, "")
 }
 
+// Verify that the signature was made by this key for the digest.
+func (pk *PublicKey) Verify(digest Checksum256, sig Signature) bool {
+	if pk.Type != sig.Type {
+		return false
+	}
+	switch pk.Type {
+	case P1:
+		key, err := p256PublicKey(pk.Data)
+		return err == nil && p256Verify(key, sig.Data, digest)
+	case WA:
+		return waVerify(pk.Data, sig.Data, digest)
+	}
+	rec, err := sig.RecoverPublicKey(digest)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(rec.Data, pk.Data)
+}
+
 // abi.Marshaler conformance
 
 func (pk PublicKey) MarshalABI(e *abi.Encoder) error {
@@ -45,3 +45,20 @@ func TestPublicKeyFromString(t *testing.T) {
 		assert.ABICoding(t, pk, []byte{0x0, 0x2, 0x52, 0x95, 0x67, 0x45, 0x91, 0x35, 0xf, 0x98, 0x3e, 0x15, 0xb7, 0x9b, 0x6e, 0xa7, 0x2, 0x5a, 0x41, 0xd, 0xc8, 0x60, 0x89, 0xa6, 0xb1, 0x32, 0xd4, 0x9, 0x9b, 0xbe, 0xe7, 0xc5, 0x1d, 0x87})
 	}
 }
+
+func TestPublicKeyP1(t *testing.T) {
+	pk := chain.NewPublicKey(chain.P1, []byte{
+		0x03, 0x6b, 0x17, 0xd1, 0xf2, 0xe1, 0x2c, 0x42, 0x47, 0xf8, 0xbc, 0xe6, 0xe5, 0x63, 0xa4, 0x40, 0xf2,
+		0x77, 0x03, 0x7d, 0x81, 0x2d, 0xeb, 0x33, 0xa0, 0xf4, 0xa1, 0x39, 0x45, 0xd8, 0x98, 0xc2, 0x96,
+	})
+	assert.ABICoding(t, pk, []byte{
+		0x01, 0x03, 0x6b, 0x17, 0xd1, 0xf2, 0xe1, 0x2c, 0x42, 0x47, 0xf8, 0xbc, 0xe6, 0xe5, 0x63, 0xa4, 0x40,
+		0xf2, 0x77, 0x03, 0x7d, 0x81, 0x2d, 0xeb, 0x33, 0xa0, 0xf4, 0xa1, 0x39, 0x45, 0xd8, 0x98, 0xc2, 0x96,
+	})
+
+	// the generator point is public key for private key 1
+	priv := chain.NewPrivateKey(chain.P1, append(make([]byte, 31), 0x01))
+	derived, err := priv.PublicKey()
+	assert.NoError(t, err)
+	assert.Equal(t, derived, pk)
+}
@@ -9,6 +9,10 @@ import (
 	"github.com/shufflingpixels/antelope-go/base58"
 )
 
+// K1 and P1 signatures are 65 bytes <recovery code><r><s> where
+// the recovery code is 27 + recovery id + 4 (compressed pubkey)
+const compactSigRecoveryOffset = 27 + 4
+
 type Signature struct {
 	Type KeyType
 	Data []byte
@@ -29,14 +33,14 @@ func NewSignatureString(s string) (*Signature, error) {
 	switch s[4:6] {
 	case "K1":
 		t = K1
-	case "P1":
+	case "P1", "R1":
 		t = P1
 	case "WA":
 		t = WA
 	default:
 		return nil, fmt.Errorf("unknown signature type: %s", s[4:6])
 	}
-	d, err := base58.CheckDecodeEosio(s[7:], t.String())
+	d, err := base58.CheckDecodeEosio(s[7:], s[4:6])
 	return &Signature{
 		Type: t,
 		Data: d,
@@ -52,7 +56,33 @@ func MustNewSignatureString(s string) Signature {
 }
 
 func (pk *Signature) String() string {
-	return "SIG_" + pk.Type.String() + "_" + base58.CheckEncodeEosio(pk.Data, pk.Type.String())
+	return "SIG_" + pk.Type.stringPrefix() + "_" + base58.CheckEncodeEosio(pk.Data, pk.Type.stringPrefix())
+}
+
+// Recover the public key that produced the signature for the digest.
+func (s *Signature) RecoverPublicKey(digest Checksum256) (*PublicKey, error) {
+	switch s.Type {
+	case K1:
+		pub, err := k1Recover(s.Data, digest)
+		if err != nil {
+			return nil, err
+		}
+		return NewPublicKey(K1, pub.SerializeCompressed()), nil
+	case P1:
+		pub, err := p256Recover(s.Data, digest)
+		if err != nil {
+			return nil, err
+		}
+		return NewPublicKey(P1, p256Compress(pub)), nil
+	case WA:
+		pub, err := waRecover(s.Data, digest)
+		if err != nil {
+			return nil, err
+		}
+		return NewPublicKey(WA, pub.bytes()), nil
+	default:
+		return nil, fmt.Errorf("unsupported signature type: %s", s.Type)
+	}
 }
 
 // abi.Marshaler conformance
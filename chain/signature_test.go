@@ -53,3 +53,66 @@ func TestSignature(t *testing.T) {
 		0x74, 0x76, 0x68, 0x50, 0x55, 0x6b, 0x3d, 0x22, 0x7d,
 	})
 }
+
+func TestSignatureRecoverPublicKey(t *testing.T) {
+	digest := chain.Checksum256Digest([]byte("hello world"))
+
+	sig := chain.MustNewSignatureString("SIG_K1_KAjEtas2bXgbCHUCxMDgYLkkAuahRw6of6PX6QYDhVVLsND8cmBEp1yEdFxm7A472drq1EfNVPXUdWkuwh6yw1R4F5TZtk")
+	pk, err := sig.RecoverPublicKey(digest)
+	assert.NoError(t, err)
+	assert.Equal(t, pk.LegacyString("EOS"), "EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV")
+
+	sig = chain.MustNewSignatureString("SIG_K1_K9kLHRyNhn7NfoNQ4rQZLNPwh5EnjDrrmDp9zG7PtjSu33kfM6PgEb18jM6Y5zHovqQVACCGN7qUb7KeGzjDBWBxXF4wPs")
+	pk, err = sig.RecoverPublicKey(digest)
+	assert.NoError(t, err)
+	assert.Equal(t, pk.LegacyString("EOS"), "EOS6bse4YptnFHNjPpWQ6irZUw8ZAmsDLvgHQavVRT9uG3ymvGZBE")
+
+	_, err = chain.NewSignature(chain.K1, []byte{0x1f, 0x00}).RecoverPublicKey(digest)
+	assert.HasError(t, &err)
+}
+
+func TestSignatureVerify(t *testing.T) {
+	digest := chain.Checksum256Digest([]byte("hello world"))
+	sig := chain.MustNewSignatureString("SIG_K1_KAjEtas2bXgbCHUCxMDgYLkkAuahRw6of6PX6QYDhVVLsND8cmBEp1yEdFxm7A472drq1EfNVPXUdWkuwh6yw1R4F5TZtk")
+
+	pk := chain.MustNewPublicKeyFromString("EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV")
+	assert.True(t, pk.Verify(digest, sig))
+	assert.True(t, !pk.Verify(chain.Checksum256Digest([]byte("hello")), sig))
+
+	other := chain.MustNewPublicKeyFromString("EOS6bse4YptnFHNjPpWQ6irZUw8ZAmsDLvgHQavVRT9uG3ymvGZBE")
+	assert.True(t, !other.Verify(digest, sig))
+}
+
+func TestSignatureWebAuthn(t *testing.T) {
+	sig := chain.MustNewSignatureString("SIG_WA_2AAAuLJS3pLPgkQQPqLsehL6VeRBaAZS7NYM91UYRUrSAEfUvzKN7DCSwhjsDqe74cZNWKUUGAHGG8ddSA7cvUxChbfKxLSrDCpwe6MVUqz4PDdyCt5tXhEJmKekxG1o1ucY3LVj8Vi9rRbzAkKPCzWqC8cPcUtpLHNG8qUKkQrN4Xuwa9W8rsBiUKwZv1ToLyVhLrJe42pvHYBXicp4E8qec5E4m6SX11KuXERFcV48Mhiie2NyaxdtNtNzQ5XZ5hjBkxRujqejpF4SNHvdAGKRBbvhkiPLA25FD3xoCbrN26z72")
+	pk := chain.MustNewPublicKeyFromString("PUB_WA_WdCPfafVNxVMiW5ybdNs83oWjenQXvSt1F49fg9mv7qrCiRwHj5b38U3ponCFWxQTkDsMC")
+
+	// challenge in client_json: "oiVr5yHH0JC6E9bDfu4qBsZjRzpAlQ1PZPCnYtvhPUk="
+	var digest chain.Checksum256
+	err := digest.UnmarshalText([]byte("a2256be721c7d090ba13d6c37eee2a06c663473a40950d4f64f0a762dbe13d49"))
+	assert.NoError(t, err)
+
+	recovered, err := sig.RecoverPublicKey(digest)
+	assert.NoError(t, err)
+	assert.Equal(t, recovered, &pk)
+	assert.True(t, pk.Verify(digest, sig))
+
+	// wrong challenge
+	_, err = sig.RecoverPublicKey(chain.Checksum256Digest([]byte("hello world")))
+	assert.HasError(t, &err)
+	assert.True(t, !pk.Verify(chain.Checksum256Digest([]byte("hello world")), sig))
+
+	// same key but different rpid
+	other := chain.NewPublicKey(chain.WA, append(append([]byte{}, pk.Data[:34]...), append([]byte{0x0b}, "example.com"...)...))
+	assert.True(t, !other.Verify(digest, sig))
+
+	// same key but user verification required
+	other = chain.NewPublicKey(chain.WA, append([]byte{}, pk.Data...))
+	other.Data[33] = 0x02
+	assert.True(t, !other.Verify(digest, sig))
+
+	// tampered client_json
+	tampered := chain.NewSignature(chain.WA, append([]byte{}, sig.Data...))
+	tampered.Data[len(tampered.Data)-3] ^= 0x01
+	assert.True(t, !pk.Verify(digest, *tampered))
+}
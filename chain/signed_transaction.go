@@ -0,0 +1,128 @@
+package chain
+
+import (
+	"github.com/shufflingpixels/antelope-go/abi"
+)
+
+// SignedTransaction is a transaction together with its signatures and context free data.
+type SignedTransaction struct {
+	Transaction
+	Signatures      []Signature `json:"signatures"`
+	ContextFreeData []Bytes     `json:"context_free_data"`
+}
+
+func NewSignedTransaction(tx Transaction, sigs []Signature, cfd []Bytes) *SignedTransaction {
+	if sigs == nil {
+		sigs = []Signature{}
+	}
+	if cfd == nil {
+		cfd = []Bytes{}
+	}
+	return &SignedTransaction{
+		Transaction:     tx,
+		Signatures:      sigs,
+		ContextFreeData: cfd,
+	}
+}
+
+// Digest that is signed by the transaction authorizers.
+func (stx SignedTransaction) SigningDigest(chainID Checksum256) Checksum256 {
+	return stx.Transaction.SigningDigest(chainID, stx.ContextFreeData)
+}
+
+// Sign the transaction with key and append the signature.
+func (stx *SignedTransaction) Sign(key *PrivateKey, chainID Checksum256) error {
+	sig, err := key.Sign(stx.SigningDigest(chainID))
+	if err != nil {
+		return err
+	}
+	stx.Signatures = append(stx.Signatures, *sig)
+	return nil
+}
+
+// Verify the signatures and return the public keys that signed the transaction,
+// in the same order as the signatures.
+func (stx SignedTransaction) Verify(chainID Checksum256) ([]PublicKey, error) {
+	digest := stx.SigningDigest(chainID)
+	keys := make([]PublicKey, 0, len(stx.Signatures))
+	for _, sig := range stx.Signatures {
+		pk, err := sig.RecoverPublicKey(digest)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, *pk)
+	}
+	return keys, nil
+}
+
+// Pack the transaction using compression.
+func (stx SignedTransaction) Pack(compression CompressionType) (*PackedTransaction, error) {
+	return NewPackedTransaction(stx.Transaction, stx.Signatures, stx.ContextFreeData, compression)
+}
+
+// abi.Marshaler conformance
+
+func (stx SignedTransaction) MarshalABI(e *abi.Encoder) error {
+	var err error
+	err = stx.Transaction.MarshalABI(e)
+	if err != nil {
+		return err
+	}
+	l := uint(len(stx.Signatures))
+	err = e.WriteVaruint(l)
+	if err != nil {
+		return err
+	}
+	for i := uint(0); i < l; i++ {
+		err = stx.Signatures[i].MarshalABI(e)
+		if err != nil {
+			return err
+		}
+	}
+	l = uint(len(stx.ContextFreeData))
+	err = e.WriteVaruint(l)
+	if err != nil {
+		return err
+	}
+	for i := uint(0); i < l; i++ {
+		err = stx.ContextFreeData[i].MarshalABI(e)
+		if err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// abi.Unmarshaler conformance
+
+func (stx *SignedTransaction) UnmarshalABI(d *abi.Decoder) error {
+	var err error
+	err = stx.Transaction.UnmarshalABI(d)
+	if err != nil {
+		return err
+	}
+	var len uint
+	len, err = d.ReadVaruint()
+	if err != nil {
+		return err
+	}
+	stx.Signatures = make([]Signature, len)
+	for i := 0; i < int(len); i++ {
+		err = stx.Signatures[i].UnmarshalABI(d)
+		if err != nil {
+			return err
+		}
+	}
+	len, err = d.ReadVaruint()
+	if err != nil {
+		return err
+	}
+	stx.ContextFreeData = make([]Bytes, len)
+	for i := 0; i < int(len); i++ {
+		err = stx.ContextFreeData[i].UnmarshalABI(d)
+		if err != nil {
+			return err
+		}
+	}
+	return err
+}
@@ -0,0 +1,104 @@
+package chain_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/shufflingpixels/antelope-go/chain"
+	"github.com/shufflingpixels/antelope-go/internal/assert"
+)
+
+func TestSignedTransaction(t *testing.T) {
+	var chainID chain.Checksum256
+	err := chainID.UnmarshalText([]byte("aca376f206b8fc25a6ed44dbdc66547c36c6c33e3a119ffbeaef943642f0e906"))
+	assert.NoError(t, err)
+
+	tx := chain.Transaction{
+		TransactionHeader: chain.TransactionHeader{
+			Expiration:       chain.TimePointSec(1234567890),
+			RefBlockNum:      11,
+			RefBlockPrefix:   22,
+			MaxNetUsageWords: 33,
+			MaxCpuUsageMs:    44,
+			DelaySec:         55,
+		},
+		ContextFreeActions: []chain.Action{},
+		Actions: []chain.Action{
+			{
+				Account: chain.N("foo"),
+				Name:    chain.N("bar"),
+				Authorization: []chain.PermissionLevel{
+					{Actor: chain.N("baz"), Permission: chain.N("qux")},
+				},
+				Data: []byte{0xde, 0xad, 0xbe, 0xef},
+			},
+		},
+		Extensions: []chain.TransactionExtension{},
+	}
+	stx := chain.NewSignedTransaction(tx, nil, []chain.Bytes{{0xbe, 0xef}})
+
+	key := chain.MustNewPrivateKeyFromString("5KQwrPbwdL6PhXujxW37FSSQZ1JiwsST4cqQzDeyXtP79zkvFD3")
+	err = stx.Sign(&key, chainID)
+	assert.NoError(t, err)
+
+	expected, _ := hex.DecodeString("d20296490b0016000000212c370001000000000000285d000000000000ae3901000000000000be39000000000000bab604deadbeef0001002003f58eeb16a0ee160ea398d49225a278a3216447a7da471d2d8b92592fb82c99435d56abd7e3f9bbc61b0091f7844516c86eaf418533779a524bf75e1da7e8070102beef")
+	assert.ABICoding(t, *stx, expected)
+	assert.JSONCoding(t, *stx, `
+		{
+			"expiration": "2009-02-13T23:31:30",
+			"ref_block_num": 11,
+			"ref_block_prefix": 22,
+			"max_net_usage_words": 33,
+			"max_cpu_usage_ms": 44,
+			"delay_sec": 55,
+			"context_free_actions": [],
+			"actions": [
+				{
+					"account": "foo",
+					"name": "bar",
+					"authorization": [
+						{
+							"actor": "baz",
+							"permission": "qux"
+						}
+					],
+					"data": "deadbeef"
+				}
+			],
+			"transaction_extensions": [],
+			"signatures": [
+				"SIG_K1_KVGMr8LbsJrMS5hPG6xhhsAWZ8BGmcEhYN6iGFQiz8piBSR4fZcu1NqAX75STz6V99LSDomx4jo4bVxrGTW4FNbeRBNY1R"
+			],
+			"context_free_data": ["beef"]
+		}
+	`)
+
+	keys, err := stx.Verify(chainID)
+	assert.NoError(t, err)
+	assert.Equal(t, len(keys), 1)
+	assert.Equal(t, keys[0].String(), "PUB_K1_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5BoDq63")
+
+	// context free data is part of the signing digest
+	stx.ContextFreeData = []chain.Bytes{}
+	keys, err = stx.Verify(chainID)
+	assert.NoError(t, err)
+	assert.True(t, keys[0].String() != "PUB_K1_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5BoDq63")
+}
+
+func TestSignedTransactionPack(t *testing.T) {
+	tx := decodeTransactionHex(t, "d20296490b0016000000212c370001000000000000285d000000000000ae39000000")
+	sig := chain.MustNewSignatureString("SIG_K1_KVGMr8LbsJrMS5hPG6xhhsAWZ8BGmcEhYN6iGFQiz8piBSR4fZcu1NqAX75STz6V99LSDomx4jo4bVxrGTW4FNbeRBNY1R")
+	stx := chain.NewSignedTransaction(tx, []chain.Signature{sig}, []chain.Bytes{{0xbe, 0xef}})
+
+	ptx, err := stx.Pack(chain.CompressionZlib)
+	assert.NoError(t, err)
+	assert.Equal(t, ptx.Signatures, stx.Signatures)
+
+	unpacked, err := ptx.Unpack()
+	assert.NoError(t, err)
+	assert.Equal(t, unpacked.ID(), tx.ID())
+
+	cfd, err := ptx.UnpackContextFreeData()
+	assert.NoError(t, err)
+	assert.Equal(t, cfd, stx.ContextFreeData)
+}
@@ -1,6 +1,13 @@
 package chain
 
-import "github.com/shufflingpixels/antelope-go/abi"
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+
+	"github.com/shufflingpixels/antelope-go/abi"
+)
 
 type TransactionHeader struct {
 	Expiration       TimePointSec `json:"expiration"`
@@ -29,6 +36,51 @@ type TransactionReceiptHeader struct {
 	NetUsageWords        uint              `json:"net_usage_words"`
 }
 
+// Set the TAPoS fields (ref_block_num and ref_block_prefix) from a block id.
+func (txh *TransactionHeader) SetReferenceBlock(id Checksum256) {
+	// first 4 bytes of the block id is the block number (big endian)
+	txh.RefBlockNum = uint16(binary.BigEndian.Uint32(id[0:4]))
+	txh.RefBlockPrefix = binary.LittleEndian.Uint32(id[8:12])
+}
+
+// Transaction id, sha256 of the packed transaction.
+func (tx Transaction) ID() Checksum256 {
+	return Checksum256Digest(tx.pack())
+}
+
+// Digest that is signed by the transaction authorizers,
+// sha256(chain_id || packed_trx || sha256(packed_context_free_data)).
+// If there is no context free data, 32 zero bytes are used instead of the hash.
+func (tx Transaction) SigningDigest(chainID Checksum256, contextFreeData []Bytes) Checksum256 {
+	b := bytes.NewBuffer(nil)
+	b.Write(chainID[:])
+	b.Write(tx.pack())
+	var cfd Checksum256
+	if len(contextFreeData) > 0 {
+		cfd = Checksum256Digest(packContextFreeData(contextFreeData))
+	}
+	b.Write(cfd[:])
+	return Checksum256Digest(b.Bytes())
+}
+
+func (tx Transaction) pack() []byte {
+	b := bytes.NewBuffer(nil)
+	err := tx.MarshalABI(NewEncoder(b))
+	if err != nil {
+		panic(err)
+	}
+	return b.Bytes()
+}
+
+func packContextFreeData(cfd []Bytes) []byte {
+	b := bytes.NewBuffer(nil)
+	err := NewEncoder(b).Encode(cfd)
+	if err != nil {
+		panic(err)
+	}
+	return b.Bytes()
+}
+
 // abi.Marshaler conformance
 
 func (txh TransactionHeader) MarshalABI(e *abi.Encoder) error {
@@ -189,3 +241,26 @@ func (tx *Transaction) UnmarshalABI(d *abi.Decoder) error {
 	}
 	return err
 }
+
+// json.Unmarshaler conformance
+
+// nodeos encodes extensions as a [type, data] pair,
+// accept that in addition to the object form.
+func (txe *TransactionExtension) UnmarshalJSON(b []byte) error {
+	if len(b) > 0 && b[0] == '[' {
+		var pair []json.RawMessage
+		if err := json.Unmarshal(b, &pair); err != nil {
+			return err
+		}
+		if len(pair) != 2 {
+			return errors.New("invalid extension, expected [type, data] pair")
+		}
+		if err := json.Unmarshal(pair[0], &txe.Type); err != nil {
+			return err
+		}
+		return json.Unmarshal(pair[1], &txe.Data)
+	}
+	// alias type to avoid infinite recursion.
+	type extension TransactionExtension
+	return json.Unmarshal(b, (*extension)(txe))
+}
@@ -46,3 +46,29 @@ func (txs *TransactionStatus) UnmarshalABI(d *abi.Decoder) error {
 	*txs = TransactionStatus(v)
 	return nil
 }
+
+// encoding.TextMarshaler conformance
+
+func (txs TransactionStatus) MarshalText() (text []byte, err error) {
+	return []byte(txs.String()), nil
+}
+
+// encoding.TextUnmarshaler conformance
+
+func (txs *TransactionStatus) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "executed":
+		*txs = TransactionStatusExecuted
+	case "soft_fail":
+		*txs = TransactionStatusSoftFail
+	case "hard_fail":
+		*txs = TransactionStatusHardFail
+	case "delayed":
+		*txs = TransactionStatusDelayed
+	case "expired":
+		*txs = TransactionStatusExpired
+	default:
+		*txs = TransactionStatusUnknown
+	}
+	return nil
+}
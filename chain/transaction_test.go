@@ -1,6 +1,9 @@
 package chain_test
 
 import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
 	"testing"
 
 	"github.com/shufflingpixels/antelope-go/chain"
@@ -81,3 +84,66 @@ func TestTransaction(t *testing.T) {
 		}
 	`)
 }
+
+func decodeTransactionHex(t *testing.T, s string) chain.Transaction {
+	var tx chain.Transaction
+	data, err := hex.DecodeString(s)
+	assert.NoError(t, err)
+	err = chain.NewDecoder(bytes.NewReader(data)).Decode(&tx)
+	assert.NoError(t, err)
+	return tx
+}
+
+func TestTransactionID(t *testing.T) {
+	// eosdactokens transfer in block 6342084 (0060c5c404a7e85d5c3e35cbaabfafad847c7c7c7035bdde307fb2c8777413f1) on EOS mainnet.
+	tx := decodeTransactionHex(t, "a9d04d5b7dc43400f690000000000180a7823423933055000000572d3ccdcd01a0986aff4998886700000000a8ed323221a0986aff49988867100261f9519b8867881300000000000004454f53444143000000")
+	assert.Equal(t, tx.ID().String(), "7074b6caaac4dfe1d19903a41b88a53b595e963bab02139a508785eba6e11ba5")
+}
+
+func TestTransactionSigningDigest(t *testing.T) {
+	var chainID chain.Checksum256
+	err := chainID.UnmarshalText([]byte("aca376f206b8fc25a6ed44dbdc66547c36c6c33e3a119ffbeaef943642f0e906"))
+	assert.NoError(t, err)
+
+	tx := decodeTransactionHex(t, "45e2ea5b22f87c6f74430000000001a0904b1822f330550040346aabab904b01a0904b1822f3305500000000a8ed32329d01fb5f27000000000027e2ea5b0000000082b4c2a389d911f1cef87b3f10dc38e8f5118ce5b83e160c5813447db849ea89c1d910841a3662747dd0e6e0040b1317be571384054a30f7e6851ebda9adab9c0a9394a5bb26479b697937fbe8b4a9d2780bee68334b2800000000000004454f5300000000000000000000000004454f53000000000000000000000000000000000000000004454f530000000000")
+	sig := chain.MustNewSignatureString("SIG_K1_KW4qcHDh6ziqWELRAsFx42sgPuP3VfCpTKX4D5A3uZhFb3fzojTeGohja19g4EJa9Zv7SrGZ47H8apo1sNa2bwPvGwW2ba")
+
+	pk, err := sig.RecoverPublicKey(tx.SigningDigest(chainID, nil))
+	assert.NoError(t, err)
+	assert.Equal(t, pk.LegacyString("EOS"), "EOS7KtnQUSGVf4vbFE2eQsWmDp4iV93jVcSmdQXtRdRRnWj2ubbFW")
+
+	// empty context free data is the same as no context free data
+	assert.Equal(t, tx.SigningDigest(chainID, []chain.Bytes{}), tx.SigningDigest(chainID, nil))
+
+	// sha256(chain_id || packed_trx || sha256(packed_context_free_data))
+	b := bytes.NewBuffer(nil)
+	err = chain.NewEncoder(b).Encode(tx)
+	assert.NoError(t, err)
+	cfd := chain.Checksum256Digest([]byte{0x02, 0x02, 0xbe, 0xef, 0x00})
+	expected := chain.Checksum256Digest(append(append(chainID[:], b.Bytes()...), cfd[:]...))
+	assert.Equal(t, tx.SigningDigest(chainID, []chain.Bytes{{0xbe, 0xef}, {}}), expected)
+}
+
+func TestTransactionHeaderSetReferenceBlock(t *testing.T) {
+	var id chain.Checksum256
+	err := id.UnmarshalText([]byte("05ae4e14d2c8d65eb6364cf65f588761f8e8156f9a2ef6b9f53fab1609066f9c"))
+	assert.NoError(t, err)
+
+	var txh chain.TransactionHeader
+	txh.SetReferenceBlock(id)
+	assert.Equal(t, txh.RefBlockNum, uint16(0x4e14))
+	assert.Equal(t, txh.RefBlockPrefix, uint32(0xf64c36b6))
+}
+
+func TestTransactionExtensionJSON(t *testing.T) {
+	ext := chain.TransactionExtension{Type: 1, Data: chain.Bytes{0xbe, 0xef}}
+	assert.JSONCoding(t, ext, `{"type": 1, "data": "beef"}`)
+
+	var decoded chain.TransactionExtension
+	err := json.Unmarshal([]byte(`[1, "beef"]`), &decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, decoded, ext)
+
+	err = json.Unmarshal([]byte(`[1]`), &decoded)
+	assert.HasError(t, &err)
+}
@@ -0,0 +1,200 @@
+package chain
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// WebAuthn (WA) helpers, mirrors the verification done by fc/nodeos.
+
+var (
+	ErrInvalidWAKey       = errors.New("invalid webauthn key")
+	ErrInvalidWASignature = errors.New("invalid webauthn signature")
+	ErrWAChallenge        = errors.New("webauthn challenge does not match digest")
+	ErrWAOrigin           = errors.New("webauthn origin must begin with https://")
+	ErrWARpIDHash         = errors.New("webauthn rpid hash does not match origin")
+)
+
+// user presence levels stored in WA public keys.
+const (
+	waUserPresenceNone     = 0
+	waUserPresencePresent  = 1
+	waUserPresenceVerified = 2
+)
+
+// authenticator data flags
+const (
+	waFlagUserPresent  = 0x01
+	waFlagUserVerified = 0x04
+)
+
+type waPublicKey struct {
+	key          []byte // compressed P-256 point
+	userPresence byte
+	rpid         string
+}
+
+type waSignature struct {
+	sig        []byte // compact P-256 signature
+	authData   []byte
+	clientJSON []byte
+}
+
+type waClientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+// Parse key data in the format <key_data[33]><user_presence><varuint rpid length><rpid>
+func parseWAPublicKey(data []byte) (*waPublicKey, error) {
+	if len(data) < 34 {
+		return nil, ErrInvalidWAKey
+	}
+	l, n := binary.Uvarint(data[34:])
+	if n <= 0 || uint64(len(data)-34-n) != l {
+		return nil, ErrInvalidWAKey
+	}
+	return &waPublicKey{
+		key:          data[:33],
+		userPresence: data[33],
+		rpid:         string(data[34+n:]),
+	}, nil
+}
+
+func (k waPublicKey) bytes() []byte {
+	tmp := make([]byte, binary.MaxVarintLen32)
+	n := binary.PutUvarint(tmp, uint64(len(k.rpid)))
+	data := make([]byte, 0, 34+n+len(k.rpid))
+	data = append(data, k.key...)
+	data = append(data, k.userPresence)
+	data = append(data, tmp[:n]...)
+	return append(data, k.rpid...)
+}
+
+// Parse signature data in the format
+// <signature[65]><varuint auth_data length><auth_data><varuint client_json length><client_json>
+func parseWASignature(data []byte) (*waSignature, error) {
+	if len(data) < 65 {
+		return nil, ErrInvalidWASignature
+	}
+	rest := data[65:]
+	al, n := binary.Uvarint(rest)
+	if n <= 0 || uint64(len(rest)-n) < al {
+		return nil, ErrInvalidWASignature
+	}
+	rest = rest[n:]
+	authData := rest[:al]
+	rest = rest[al:]
+	cl, n := binary.Uvarint(rest)
+	if n <= 0 || uint64(len(rest)-n) != cl {
+		return nil, ErrInvalidWASignature
+	}
+	return &waSignature{
+		sig:        data[:65],
+		authData:   authData,
+		clientJSON: rest[n:],
+	}, nil
+}
+
+// Check the client data and authenticator data against the digest.
+// returns the digest the authenticator actually signed
+// along with the user presence level and rpid.
+func (s waSignature) check(digest Checksum256) (signed Checksum256, up byte, rpid string, err error) {
+	var cd waClientData
+	if err = json.Unmarshal(s.clientJSON, &cd); err != nil {
+		return
+	}
+	if cd.Type != "webauthn.get" {
+		err = ErrInvalidWASignature
+		return
+	}
+	challenge, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(cd.Challenge, "="))
+	if err != nil {
+		return
+	}
+	if !bytes.Equal(challenge, digest[:]) {
+		err = ErrWAChallenge
+		return
+	}
+	if !strings.HasPrefix(cd.Origin, "https://") {
+		err = ErrWAOrigin
+		return
+	}
+	// strip port
+	rpid = cd.Origin[len("https://"):]
+	if i := strings.LastIndexByte(rpid, ':'); i >= 0 {
+		rpid = rpid[:i]
+	}
+
+	// auth_data is <rpid hash[32]><flags><counter[4]>...
+	if len(s.authData) < 37 {
+		err = ErrInvalidWASignature
+		return
+	}
+	rpidHash := sha256.Sum256([]byte(rpid))
+	if !bytes.Equal(s.authData[:32], rpidHash[:]) {
+		err = ErrWARpIDHash
+		return
+	}
+	up = waUserPresenceNone
+	if s.authData[32]&waFlagUserPresent != 0 {
+		up = waUserPresencePresent
+	}
+	if s.authData[32]&waFlagUserVerified != 0 {
+		up = waUserPresenceVerified
+	}
+
+	// signature is over sha256(auth_data || sha256(client_json))
+	clientHash := sha256.Sum256(s.clientJSON)
+	h := sha256.New()
+	h.Write(s.authData)
+	h.Write(clientHash[:])
+	copy(signed[:], h.Sum(nil))
+	return
+}
+
+func waRecover(data []byte, digest Checksum256) (*waPublicKey, error) {
+	s, err := parseWASignature(data)
+	if err != nil {
+		return nil, err
+	}
+	signed, up, rpid, err := s.check(digest)
+	if err != nil {
+		return nil, err
+	}
+	pub, err := p256Recover(s.sig, signed)
+	if err != nil {
+		return nil, err
+	}
+	return &waPublicKey{
+		key:          p256Compress(pub),
+		userPresence: up,
+		rpid:         rpid,
+	}, nil
+}
+
+func waVerify(keyData []byte, sigData []byte, digest Checksum256) bool {
+	k, err := parseWAPublicKey(keyData)
+	if err != nil {
+		return false
+	}
+	s, err := parseWASignature(sigData)
+	if err != nil {
+		return false
+	}
+	signed, up, rpid, err := s.check(digest)
+	if err != nil || up != k.userPresence || rpid != k.rpid {
+		return false
+	}
+	key, err := p256PublicKey(k.key)
+	if err != nil {
+		return false
+	}
+	return p256Verify(key, s.sig, signed)
+}